@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	dumpOutput = ""
+)
+
+var (
+	dumpCmd = &cobra.Command{
+		Use:   "dump",
+		Short: "Dump the Kubernetes data of a live cluster",
+		Long:  `Collect the Kubernetes data of a live cluster and write it to a directory or .tar.gz archive in the layout expected by the file collectors`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return core.Dump(context.Background(), dumpOutput, launchOptions()...)
+		},
+	}
+)
+
+func init() {
+	dumpCmd.Flags().StringVarP(&dumpOutput, "output", "o", dumpOutput, "output directory or .tar.gz archive")
+	_ = dumpCmd.MarkFlagRequired("output")
+
+	rootCmd.AddCommand(dumpCmd)
+}
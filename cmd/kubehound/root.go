@@ -8,7 +8,10 @@ import (
 )
 
 var (
-	cfgFile = ""
+	cfgFile    = ""
+	kubeconfig = ""
+	kubeCtx    = ""
+	inCluster  = false
 )
 
 var (
@@ -17,11 +20,24 @@ var (
 		Short: "A local Kubehound instance",
 		Long:  `A local instance of Kubehound - a Kubernetes attack path generator`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return core.Launch(context.Background(), core.WithConfigPath(cfgFile))
+			return core.Launch(context.Background(), launchOptions()...)
 		},
 	}
 )
 
+// launchOptions builds the core launch options from the persistent command line flags.
+func launchOptions() []core.LaunchOption {
+	return []core.LaunchOption{
+		core.WithConfigPath(cfgFile),
+		core.WithKubeconfig(kubeconfig),
+		core.WithContext(kubeCtx),
+		core.WithInCluster(inCluster),
+	}
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", cfgFile, "application config file")
+	rootCmd.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", kubeconfig, "kubeconfig file used by the live collectors")
+	rootCmd.PersistentFlags().StringVar(&kubeCtx, "context", kubeCtx, "kubeconfig context used by the live collectors")
+	rootCmd.PersistentFlags().BoolVar(&inCluster, "in-cluster", inCluster, "use the in-cluster service account in the live collectors")
 }
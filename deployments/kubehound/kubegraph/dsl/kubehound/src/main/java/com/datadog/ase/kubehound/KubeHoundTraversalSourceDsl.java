@@ -209,6 +209,23 @@ public class KubeHoundTraversalSourceDsl extends GraphTraversalSource {
         return traversal;
     }
 
+    /**
+     * Starts a traversal that finds all vertices with a "Ingress" label (ingresses and Gateway API HTTP routes) and 
+     * optionally allows filtering of those vertices on the "hosts" property.
+     *
+     * @param hosts list of hostnames to filter on
+     */
+    public GraphTraversal<Vertex, Vertex> ingresses(String... hosts) {
+        GraphTraversal traversal = this.clone().V();
+        
+        traversal = traversal.hasLabel("Ingress");
+        if (hosts.length > 0) {
+            traversal = traversal.has("hosts", P.within(hosts));
+        } 
+
+        return traversal;
+    }
+
     /**
      * Starts a traversal that finds all vertices with a "Volume" label and optionally allows filtering of those
      * vertices on the "name" property.
@@ -21,6 +21,8 @@ permissionSet = mgmt.makeVertexLabel('PermissionSet').make();
 volume = mgmt.makeVertexLabel('Volume').make();
 endpoint = mgmt.makeVertexLabel('Endpoint').make();
 route = mgmt.makeVertexLabel('Route').make();
+namespaceVertex = mgmt.makeVertexLabel('Namespace').make();
+ingress = mgmt.makeVertexLabel('Ingress').make();
 
 // Create our edge labels and connections
 permissionDiscover = mgmt.makeEdgeLabel('PERMISSION_DISCOVER').multiplicity(MULTI).make();
@@ -51,7 +53,7 @@ idAssume = mgmt.makeEdgeLabel('IDENTITY_ASSUME').multiplicity(MANY2ONE).make();
 mgmt.addConnection(idAssume, container, identity);
 mgmt.addConnection(idAssume, node, identity);
 
-idImpersonate = mgmt.makeEdgeLabel('IDENTITY_IMPERSONATE').multiplicity(MANY2ONE).make();
+idImpersonate = mgmt.makeEdgeLabel('IDENTITY_IMPERSONATE').multiplicity(MULTI).make();
 mgmt.addConnection(idImpersonate, permissionSet, identity);
 
 roleBind = mgmt.makeEdgeLabel('ROLE_BIND').multiplicity(MULTI).make();
@@ -82,6 +84,9 @@ mgmt.addConnection(tokenBruteforce, permissionSet, identity);
 tokenList = mgmt.makeEdgeLabel('TOKEN_LIST').multiplicity(MULTI).make();
 mgmt.addConnection(tokenList, permissionSet, identity);
 
+secretRead = mgmt.makeEdgeLabel('SECRET_READ').multiplicity(MULTI).make();
+mgmt.addConnection(secretRead, permissionSet, identity);
+
 nsenter = mgmt.makeEdgeLabel('CE_NSENTER').multiplicity(MANY2ONE).make();
 mgmt.addConnection(nsenter, container, node);
 
@@ -100,9 +105,28 @@ mgmt.addConnection(sysPtrace, container, node);
 varLogSymLink = mgmt.makeEdgeLabel('CE_VAR_LOG_SYMLINK').multiplicity(MULTI).make();
 mgmt.addConnection(varLogSymLink, container, node);
 
+netMitm = mgmt.makeEdgeLabel('CE_NET_MITM').multiplicity(MULTI).make();
+mgmt.addConnection(netMitm, container, container);
+mgmt.addConnection(netMitm, container, endpoint);
+
+runtimeSock = mgmt.makeEdgeLabel('EXPLOIT_CONTAINERD_SOCK').multiplicity(MANY2ONE).make();
+mgmt.addConnection(runtimeSock, container, node);
+
 endpointExploit = mgmt.makeEdgeLabel('ENDPOINT_EXPLOIT').multiplicity(MULTI).make();
 mgmt.addConnection(endpointExploit, endpoint, container);
 
+ingressExpose = mgmt.makeEdgeLabel('INGRESS_EXPOSE').multiplicity(MULTI).make();
+mgmt.addConnection(ingressExpose, ingress, endpoint);
+
+webhookWrite = mgmt.makeEdgeLabel('WEBHOOK_WRITE').multiplicity(MULTI).make();
+mgmt.addConnection(webhookWrite, permissionSet, node);
+
+webhookMutate = mgmt.makeEdgeLabel('WEBHOOK_MUTATE').multiplicity(MULTI).make();
+mgmt.addConnection(webhookMutate, container, pod);
+
+idMember = mgmt.makeEdgeLabel('IDENTITY_MEMBER').multiplicity(MULTI).make();
+mgmt.addConnection(idMember, identity, identity);
+
 // All properties we will index on
 cls = mgmt.makePropertyKey('class').dataType(String.class).cardinality(Cardinality.SINGLE).make();
 cluster = mgmt.makePropertyKey('cluster').dataType(String.class).cardinality(Cardinality.SINGLE).make();
@@ -136,9 +160,11 @@ hostNetwork = mgmt.makePropertyKey('hostNetwork').dataType(Boolean.class).cardin
 hostPid = mgmt.makePropertyKey('hostPid').dataType(Boolean.class).cardinality(Cardinality.SINGLE).make();
 hostIpc = mgmt.makePropertyKey('hostIpc').dataType(Boolean.class).cardinality(Cardinality.SINGLE).make();
 privesc = mgmt.makePropertyKey('privesc').dataType(Boolean.class).cardinality(Cardinality.SINGLE).make();
+containerType = mgmt.makePropertyKey('containerType').dataType(String.class).cardinality(Cardinality.SINGLE).make();
 privileged = mgmt.makePropertyKey('privileged').dataType(Boolean.class).cardinality(Cardinality.SINGLE).make();
 runAsUser = mgmt.makePropertyKey('runAsUser').dataType(Long.class).cardinality(Cardinality.SINGLE).make();
 rules = mgmt.makePropertyKey('rules').dataType(String.class).cardinality(Cardinality.LIST).make();
+aggregated = mgmt.makePropertyKey('aggregated').dataType(String.class).cardinality(Cardinality.LIST).make();
 command = mgmt.makePropertyKey('command').dataType(String.class).cardinality(Cardinality.LIST).make();
 args = mgmt.makePropertyKey('args').dataType(String.class).cardinality(Cardinality.LIST).make();
 capabilities = mgmt.makePropertyKey('capabilities').dataType(String.class).cardinality(Cardinality.LIST).make();
@@ -149,19 +175,31 @@ addresses = mgmt.makePropertyKey('addresses').dataType(String.class).cardinality
 protocol = mgmt.makePropertyKey('protocol').dataType(String.class).cardinality(Cardinality.SINGLE).make();
 role = mgmt.makePropertyKey('role').dataType(String.class).cardinality(Cardinality.SINGLE).make();
 roleBinding = mgmt.makePropertyKey('roleBinding').dataType(String.class).cardinality(Cardinality.SINGLE).make();
+labels = mgmt.makePropertyKey('labels').dataType(String.class).cardinality(Cardinality.LIST).make();
+podSecurityEnforce = mgmt.makePropertyKey('podSecurityEnforce').dataType(String.class).cardinality(Cardinality.SINGLE).make();
+privilegedBlocked = mgmt.makePropertyKey('privilegedBlocked').dataType(Boolean.class).cardinality(Cardinality.SINGLE).make();
+ingressClass = mgmt.makePropertyKey('ingressClass').dataType(String.class).cardinality(Cardinality.SINGLE).make();
+hosts = mgmt.makePropertyKey('hosts').dataType(String.class).cardinality(Cardinality.LIST).make();
+gateways = mgmt.makePropertyKey('gateways').dataType(String.class).cardinality(Cardinality.LIST).make();
+networkPolicy = mgmt.makePropertyKey('networkPolicy').dataType(String.class).cardinality(Cardinality.SINGLE).make();
 
 
 // Define properties for each vertex 
 mgmt.addProperties(container, cls, cluster, runID, storeID, app, team, service, isNamespaced, namespace, name, image, privileged, privesc, hostPid, 
-    hostIpc, hostNetwork, runAsUser, podName, nodeName, compromised, command, args, capabilities, ports);
+    hostIpc, hostNetwork, runAsUser, podName, nodeName, compromised, command, args, capabilities, ports, containerType);
 mgmt.addProperties(identity, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, type, critical);
 mgmt.addProperties(node, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, compromised, critical);
 mgmt.addProperties(pod, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, sharedPs, serviceAccount, nodeName, compromised, critical);
-mgmt.addProperties(permissionSet, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, role, roleBinding, rules, critical);
+mgmt.addProperties(permissionSet, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, role, roleBinding, rules, aggregated, critical);
 mgmt.addProperties(volume, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, type, sourcePath, mountPath, readonly);
 mgmt.addProperties(endpoint, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, serviceEndpoint, serviceDns, addressType, 
     addresses, port, portName, protocol, exposure, compromised);
 mgmt.addProperties(route, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace)
+mgmt.addProperties(namespaceVertex, cls, cluster, runID, storeID, app, team, service, name, labels, podSecurityEnforce, privilegedBlocked);
+mgmt.addProperties(ingress, cls, cluster, runID, storeID, app, team, service, name, isNamespaced, namespace, type, ingressClass, hosts, gateways);
+
+// Define properties for each edge
+mgmt.addProperties(endpointExploit, networkPolicy);
 
 
 // Create the indexes on vertex properties
@@ -7,6 +7,8 @@ import (
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals/types"
 	"github.com/DataDog/KubeHound/pkg/kubehound/services"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // ClusterInfo encapsulates the target cluster information for the current run.
@@ -70,6 +72,82 @@ type EndpointIngestor interface {
 	Complete(context.Context) error
 }
 
+// NamespaceIngestor defines the interface to allow an ingestor to consume namespace inputs from a collector.
+//
+//go:generate mockery --name NamespaceIngestor --output mockingest --case underscore --filename namespace_ingestor.go --with-expecter
+type NamespaceIngestor interface {
+	IngestNamespace(context.Context, types.NamespaceType) error
+	Complete(context.Context) error
+}
+
+// ServiceIngestor defines the interface to allow an ingestor to consume service inputs from a collector.
+//
+//go:generate mockery --name ServiceIngestor --output mockingest --case underscore --filename service_ingestor.go --with-expecter
+type ServiceIngestor interface {
+	IngestService(context.Context, types.ServiceType) error
+	Complete(context.Context) error
+}
+
+// NetworkPolicyIngestor defines the interface to allow an ingestor to consume network policy inputs from a collector.
+//
+//go:generate mockery --name NetworkPolicyIngestor --output mockingest --case underscore --filename network_policy_ingestor.go --with-expecter
+type NetworkPolicyIngestor interface {
+	IngestNetworkPolicy(context.Context, types.NetworkPolicyType) error
+	Complete(context.Context) error
+}
+
+// SecretIngestor defines the interface to allow an ingestor to consume secret inputs from a collector. The secrets are
+// redacted by the collector and only hold their metadata and type.
+//
+//go:generate mockery --name SecretIngestor --output mockingest --case underscore --filename secret_ingestor.go --with-expecter
+type SecretIngestor interface {
+	IngestSecret(context.Context, types.SecretType) error
+	Complete(context.Context) error
+}
+
+// WebhookConfigurationIngestor defines the interface to allow an ingestor to consume admission webhook configuration
+// inputs from a collector.
+//
+//go:generate mockery --name WebhookConfigurationIngestor --output mockingest --case underscore --filename webhook_configuration_ingestor.go --with-expecter
+type WebhookConfigurationIngestor interface {
+	IngestMutatingWebhookConfiguration(context.Context, types.MutatingWebhookConfigurationType) error
+	IngestValidatingWebhookConfiguration(context.Context, types.ValidatingWebhookConfigurationType) error
+	Complete(context.Context) error
+}
+
+// CustomResourceIngestor defines the interface to allow an ingestor to consume the objects of the configured custom
+// resources from a collector. The resource of each object is provided as it cannot be derived from the object itself.
+//
+//go:generate mockery --name CustomResourceIngestor --output mockingest --case underscore --filename custom_resource_ingestor.go --with-expecter
+type CustomResourceIngestor interface {
+	IngestCustomResource(context.Context, schema.GroupVersionResource, types.CustomResourceType) error
+	Complete(context.Context) error
+}
+
+// WorkloadIngestor defines the interface to allow an ingestor to consume workload controller inputs from a collector.
+//
+//go:generate mockery --name WorkloadIngestor --output mockingest --case underscore --filename workload_ingestor.go --with-expecter
+type WorkloadIngestor interface {
+	IngestDeployment(context.Context, types.DeploymentType) error
+	IngestDaemonSet(context.Context, types.DaemonSetType) error
+	IngestStatefulSet(context.Context, types.StatefulSetType) error
+	IngestReplicaSet(context.Context, types.ReplicaSetType) error
+	IngestJob(context.Context, types.JobType) error
+	IngestCronJob(context.Context, types.CronJobType) error
+	Complete(context.Context) error
+}
+
+// IngressIngestor defines the interface to allow an ingestor to consume the traffic entry point inputs (ingresses and
+// Gateway API gateways and HTTP routes) from a collector.
+//
+//go:generate mockery --name IngressIngestor --output mockingest --case underscore --filename ingress_ingestor.go --with-expecter
+type IngressIngestor interface {
+	IngestIngress(context.Context, types.IngressType) error
+	IngestGateway(context.Context, types.GatewayType) error
+	IngestHTTPRoute(context.Context, types.HTTPRouteType) error
+	Complete(context.Context) error
+}
+
 //go:generate mockery --name CollectorClient --output mockcollector --case underscore --filename collector_client.go --with-expecter
 type CollectorClient interface {
 	services.Dependency
@@ -77,6 +155,10 @@ type CollectorClient interface {
 	// ClusterInfo returns the target cluster information for the current run.
 	ClusterInfo(ctx context.Context) (*ClusterInfo, error)
 
+	// APIResources returns the resources served by the target cluster with their supported verbs, as reported by the API discovery.
+	// A nil result is returned if the discovery is not available (e.g file collector dumps without a discovery file).
+	APIResources(ctx context.Context) ([]*metav1.APIResourceList, error)
+
 	// StreamNodes will iterate through all NodeType objects collected by the collector and invoke the ingestor.IngestNode method on each.
 	// Once all the NodeType objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
 	StreamNodes(ctx context.Context, ingestor NodeIngestor) error
@@ -105,6 +187,43 @@ type CollectorClient interface {
 	// Once all the EndpointType objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
 	StreamEndpoints(ctx context.Context, ingestor EndpointIngestor) error
 
+	// StreamNamespaces will iterate through all NamespaceType objects collected by the collector and invoke the ingestor.IngestNamespace method on each.
+	// Once all the NamespaceType objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamNamespaces(ctx context.Context, ingestor NamespaceIngestor) error
+
+	// StreamServices will iterate through all ServiceType objects collected by the collector and invoke the ingestor.IngestService method on each.
+	// Once all the ServiceType objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamServices(ctx context.Context, ingestor ServiceIngestor) error
+
+	// StreamWorkloads will iterate through all the workload controller objects (deployments, daemon sets, stateful sets, replica sets,
+	// jobs and cron jobs) collected by the collector and invoke the corresponding ingestor.IngestXXX method on each.
+	// Once all the workload objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamWorkloads(ctx context.Context, ingestor WorkloadIngestor) error
+
+	// StreamIngresses will iterate through all the traffic entry point objects (ingresses, Gateway API gateways and HTTP routes)
+	// collected by the collector and invoke the corresponding ingestor.IngestXXX method on each. Gateways are streamed before
+	// the HTTP routes attached to them. Once all the objects have been exhausted the ingestor.Complete method will be invoked
+	// to signal the end of the stream.
+	StreamIngresses(ctx context.Context, ingestor IngressIngestor) error
+
+	// StreamNetworkPolicies will iterate through all NetworkPolicyType objects collected by the collector and invoke the ingestor.IngestNetworkPolicy method on each.
+	// Once all the NetworkPolicyType objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamNetworkPolicies(ctx context.Context, ingestor NetworkPolicyIngestor) error
+
+	// StreamSecrets will iterate through all SecretType objects collected by the collector and invoke the ingestor.IngestSecret method on each.
+	// The data of the secrets is never provided to the ingestor. Once all the SecretType objects have been exhausted the ingestor.Complete
+	// method will be invoked to signal the end of the stream.
+	StreamSecrets(ctx context.Context, ingestor SecretIngestor) error
+
+	// StreamWebhookConfigurations will iterate through all the admission webhook configuration objects (mutating and validating)
+	// collected by the collector and invoke the corresponding ingestor.IngestXXX method on each.
+	// Once all the objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamWebhookConfigurations(ctx context.Context, ingestor WebhookConfigurationIngestor) error
+
+	// StreamCustomResources will iterate through the objects of all the custom resources configured for collection and invoke the ingestor.IngestCustomResource method on each.
+	// Once all the objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamCustomResources(ctx context.Context, ingestor CustomResourceIngestor) error
+
 	// Close cleans up any resources used by the collector client implementation. Client cannot be reused after this call.
 	Close(ctx context.Context) error
 }
@@ -138,6 +257,10 @@ type OpenShiftCollectorClient interface {
 	// StreamRoutes will iterate through all Route objects and invoke the ingestor.IngestRoute method on each.
 	// Once all the Route objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
 	StreamRoutes(ctx context.Context, ingestor RouteIngestor) error
+
+	// StreamGroups will iterate through all Group objects (user.openshift.io) and invoke the ingestor.IngestGroup method on each.
+	// Once all the Group objects have been exhausted the ingestor.Complete method will be invoked to signal the end of the stream.
+	StreamGroups(ctx context.Context, ingestor GroupIngestor) error
 }
 
 // RouteIngestor defines the interface to allow an ingestor to consume route inputs from a collector.
@@ -147,3 +270,11 @@ type RouteIngestor interface {
 	IngestRoute(context.Context, types.RouteType) error
 	Complete(context.Context) error
 }
+
+// GroupIngestor defines the interface to allow an ingestor to consume OpenShift group inputs from a collector.
+//
+//go:generate mockery --name GroupIngestor --output mockingest --case underscore --filename group_ingestor.go --with-expecter
+type GroupIngestor interface {
+	IngestGroup(context.Context, types.GroupType) error
+	Complete(context.Context) error
+}
@@ -0,0 +1,609 @@
+package collector
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	routev1 "github.com/openshift/api/route/v1"
+	userv1 "github.com/openshift/api/user/v1"
+)
+
+// The list files mirror the generic list format produced by kubectl get -o json, with the items streamed in between.
+const (
+	dumpListHeader  = `{"apiVersion":"v1","kind":"List","items":[`
+	dumpListTrailer = `]}`
+)
+
+// dumpListWriter incrementally encodes a list file, writing each object as soon as it is streamed.
+type dumpListWriter struct {
+	w     io.WriteCloser
+	buf   *bufio.Writer
+	path  string
+	count int
+}
+
+func (l *dumpListWriter) add(item any) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("marshalling dump file %s item: %w", l.path, err)
+	}
+
+	if l.count > 0 {
+		if err := l.buf.WriteByte(','); err != nil {
+			return fmt.Errorf("writing dump file %s: %w", l.path, err)
+		}
+	}
+
+	if _, err := l.buf.Write(data); err != nil {
+		return fmt.Errorf("writing dump file %s: %w", l.path, err)
+	}
+	l.count++
+
+	return nil
+}
+
+// close terminates the list and flushes the file to the dump writer.
+func (l *dumpListWriter) close() error {
+	if _, err := l.buf.WriteString(dumpListTrailer); err != nil {
+		_ = l.w.Close()
+
+		return fmt.Errorf("writing dump file %s: %w", l.path, err)
+	}
+
+	if err := l.buf.Flush(); err != nil {
+		_ = l.w.Close()
+
+		return fmt.Errorf("writing dump file %s: %w", l.path, err)
+	}
+
+	return l.w.Close()
+}
+
+// dumpStream writes the objects of a single entity type to the file collector layout as they are streamed, keeping
+// a list file open per namespace (or a single root file for cluster scoped objects) until the stream completes.
+type dumpStream[T any] struct {
+	d     *dumper
+	file  string
+	lists map[string]*dumpListWriter
+}
+
+func newDumpStream[T any](d *dumper, file string) *dumpStream[T] {
+	return &dumpStream[T]{
+		d:     d,
+		file:  file,
+		lists: make(map[string]*dumpListWriter),
+	}
+}
+
+func (b *dumpStream[T]) add(ctx context.Context, namespace string, item T) error {
+	l, ok := b.lists[namespace]
+	if !ok {
+		var err error
+		l, err = b.d.createList(ctx, namespace, b.file)
+		if err != nil {
+			return err
+		}
+		b.lists[namespace] = l
+	}
+
+	return l.add(item)
+}
+
+// Complete closes the list file of every namespace seen in the stream.
+func (b *dumpStream[T]) Complete(_ context.Context) error {
+	for namespace, l := range b.lists {
+		delete(b.lists, namespace)
+		if err := b.d.closeList(l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type nodeDumpIngestor struct{ *dumpStream[corev1.Node] }
+
+func (i *nodeDumpIngestor) IngestNode(ctx context.Context, node types.NodeType) error {
+	return i.add(ctx, "", *node)
+}
+
+type namespaceDumpIngestor struct {
+	*dumpStream[corev1.Namespace]
+}
+
+func (i *namespaceDumpIngestor) IngestNamespace(ctx context.Context, ns types.NamespaceType) error {
+	return i.add(ctx, "", *ns)
+}
+
+type podDumpIngestor struct{ *dumpStream[corev1.Pod] }
+
+func (i *podDumpIngestor) IngestPod(ctx context.Context, pod types.PodType) error {
+	return i.add(ctx, pod.Namespace, *pod)
+}
+
+type roleDumpIngestor struct{ *dumpStream[rbacv1.Role] }
+
+func (i *roleDumpIngestor) IngestRole(ctx context.Context, role types.RoleType) error {
+	return i.add(ctx, role.Namespace, *role)
+}
+
+type clusterRoleDumpIngestor struct {
+	*dumpStream[rbacv1.ClusterRole]
+}
+
+func (i *clusterRoleDumpIngestor) IngestClusterRole(ctx context.Context, role types.ClusterRoleType) error {
+	return i.add(ctx, "", *role)
+}
+
+type roleBindingDumpIngestor struct {
+	*dumpStream[rbacv1.RoleBinding]
+}
+
+func (i *roleBindingDumpIngestor) IngestRoleBinding(ctx context.Context, rb types.RoleBindingType) error {
+	return i.add(ctx, rb.Namespace, *rb)
+}
+
+type clusterRoleBindingDumpIngestor struct {
+	*dumpStream[rbacv1.ClusterRoleBinding]
+}
+
+func (i *clusterRoleBindingDumpIngestor) IngestClusterRoleBinding(ctx context.Context, crb types.ClusterRoleBindingType) error {
+	return i.add(ctx, "", *crb)
+}
+
+type endpointDumpIngestor struct {
+	*dumpStream[discoveryv1.EndpointSlice]
+}
+
+func (i *endpointDumpIngestor) IngestEndpoint(ctx context.Context, eps types.EndpointType) error {
+	return i.add(ctx, eps.Namespace, *eps)
+}
+
+type serviceDumpIngestor struct {
+	*dumpStream[corev1.Service]
+}
+
+func (i *serviceDumpIngestor) IngestService(ctx context.Context, svc types.ServiceType) error {
+	return i.add(ctx, svc.Namespace, *svc)
+}
+
+// workloadDumpIngestor writes each kind of workload controller to its own file.
+type workloadDumpIngestor struct {
+	deployments  *dumpStream[appsv1.Deployment]
+	daemonSets   *dumpStream[appsv1.DaemonSet]
+	statefulSets *dumpStream[appsv1.StatefulSet]
+	replicaSets  *dumpStream[appsv1.ReplicaSet]
+	jobs         *dumpStream[batchv1.Job]
+	cronJobs     *dumpStream[batchv1.CronJob]
+}
+
+func newWorkloadDumpIngestor(d *dumper) *workloadDumpIngestor {
+	return &workloadDumpIngestor{
+		deployments:  newDumpStream[appsv1.Deployment](d, deploymentPath),
+		daemonSets:   newDumpStream[appsv1.DaemonSet](d, daemonSetPath),
+		statefulSets: newDumpStream[appsv1.StatefulSet](d, statefulSetPath),
+		replicaSets:  newDumpStream[appsv1.ReplicaSet](d, replicaSetPath),
+		jobs:         newDumpStream[batchv1.Job](d, jobPath),
+		cronJobs:     newDumpStream[batchv1.CronJob](d, cronJobPath),
+	}
+}
+
+func (i *workloadDumpIngestor) IngestDeployment(ctx context.Context, deploy types.DeploymentType) error {
+	return i.deployments.add(ctx, deploy.Namespace, *deploy)
+}
+
+func (i *workloadDumpIngestor) IngestDaemonSet(ctx context.Context, ds types.DaemonSetType) error {
+	return i.daemonSets.add(ctx, ds.Namespace, *ds)
+}
+
+func (i *workloadDumpIngestor) IngestStatefulSet(ctx context.Context, sts types.StatefulSetType) error {
+	return i.statefulSets.add(ctx, sts.Namespace, *sts)
+}
+
+func (i *workloadDumpIngestor) IngestReplicaSet(ctx context.Context, rs types.ReplicaSetType) error {
+	return i.replicaSets.add(ctx, rs.Namespace, *rs)
+}
+
+func (i *workloadDumpIngestor) IngestJob(ctx context.Context, job types.JobType) error {
+	return i.jobs.add(ctx, job.Namespace, *job)
+}
+
+func (i *workloadDumpIngestor) IngestCronJob(ctx context.Context, cj types.CronJobType) error {
+	return i.cronJobs.add(ctx, cj.Namespace, *cj)
+}
+
+func (i *workloadDumpIngestor) Complete(ctx context.Context) error {
+	for _, b := range []interface{ Complete(context.Context) error }{
+		i.deployments, i.daemonSets, i.statefulSets, i.replicaSets, i.jobs, i.cronJobs,
+	} {
+		if err := b.Complete(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ingressDumpIngestor writes each kind of traffic entry point to its own file.
+type ingressDumpIngestor struct {
+	ingresses  *dumpStream[networkingv1.Ingress]
+	gateways   *dumpStream[gatewayapi.Gateway]
+	httpRoutes *dumpStream[gatewayapi.HTTPRoute]
+}
+
+func newIngressDumpIngestor(d *dumper) *ingressDumpIngestor {
+	return &ingressDumpIngestor{
+		ingresses:  newDumpStream[networkingv1.Ingress](d, ingressPath),
+		gateways:   newDumpStream[gatewayapi.Gateway](d, gatewayPath),
+		httpRoutes: newDumpStream[gatewayapi.HTTPRoute](d, httpRoutePath),
+	}
+}
+
+func (i *ingressDumpIngestor) IngestIngress(ctx context.Context, ing types.IngressType) error {
+	return i.ingresses.add(ctx, ing.Namespace, *ing)
+}
+
+func (i *ingressDumpIngestor) IngestGateway(ctx context.Context, gw types.GatewayType) error {
+	return i.gateways.add(ctx, gw.Namespace, *gw)
+}
+
+func (i *ingressDumpIngestor) IngestHTTPRoute(ctx context.Context, route types.HTTPRouteType) error {
+	return i.httpRoutes.add(ctx, route.Namespace, *route)
+}
+
+func (i *ingressDumpIngestor) Complete(ctx context.Context) error {
+	for _, b := range []interface{ Complete(context.Context) error }{
+		i.ingresses, i.gateways, i.httpRoutes,
+	} {
+		if err := b.Complete(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type networkPolicyDumpIngestor struct {
+	*dumpStream[networkingv1.NetworkPolicy]
+}
+
+func (i *networkPolicyDumpIngestor) IngestNetworkPolicy(ctx context.Context, policy types.NetworkPolicyType) error {
+	return i.add(ctx, policy.Namespace, *policy)
+}
+
+type webhookConfigurationDumpIngestor struct {
+	mutating   *dumpStream[admissionregistrationv1.MutatingWebhookConfiguration]
+	validating *dumpStream[admissionregistrationv1.ValidatingWebhookConfiguration]
+}
+
+func newWebhookConfigurationDumpIngestor(d *dumper) *webhookConfigurationDumpIngestor {
+	return &webhookConfigurationDumpIngestor{
+		mutating:   newDumpStream[admissionregistrationv1.MutatingWebhookConfiguration](d, mutatingWebhookPath),
+		validating: newDumpStream[admissionregistrationv1.ValidatingWebhookConfiguration](d, validatingWebhookPath),
+	}
+}
+
+func (i *webhookConfigurationDumpIngestor) IngestMutatingWebhookConfiguration(ctx context.Context,
+	config types.MutatingWebhookConfigurationType) error {
+
+	return i.mutating.add(ctx, "", *config)
+}
+
+func (i *webhookConfigurationDumpIngestor) IngestValidatingWebhookConfiguration(ctx context.Context,
+	config types.ValidatingWebhookConfigurationType) error {
+
+	return i.validating.add(ctx, "", *config)
+}
+
+func (i *webhookConfigurationDumpIngestor) Complete(ctx context.Context) error {
+	if err := i.mutating.Complete(ctx); err != nil {
+		return err
+	}
+
+	return i.validating.Complete(ctx)
+}
+
+// customResourceDumpIngestor writes the objects of each custom resource to its own file.
+type customResourceDumpIngestor struct {
+	d       *dumper
+	streams map[schema.GroupResource]*dumpStream[map[string]any]
+}
+
+func newCustomResourceDumpIngestor(d *dumper) *customResourceDumpIngestor {
+	return &customResourceDumpIngestor{
+		d:       d,
+		streams: make(map[schema.GroupResource]*dumpStream[map[string]any]),
+	}
+}
+
+func (i *customResourceDumpIngestor) IngestCustomResource(ctx context.Context, gvr schema.GroupVersionResource,
+	cr types.CustomResourceType) error {
+
+	gr := gvr.GroupResource()
+	b, ok := i.streams[gr]
+	if !ok {
+		b = newDumpStream[map[string]any](i.d, customResourcePath(gr))
+		i.streams[gr] = b
+	}
+
+	u := (*unstructured.Unstructured)(cr)
+
+	return b.add(ctx, u.GetNamespace(), u.UnstructuredContent())
+}
+
+func (i *customResourceDumpIngestor) Complete(ctx context.Context) error {
+	for _, b := range i.streams {
+		if err := b.Complete(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type secretDumpIngestor struct{ *dumpStream[corev1.Secret] }
+
+func (i *secretDumpIngestor) IngestSecret(ctx context.Context, secret types.SecretType) error {
+	return i.add(ctx, secret.Namespace, *secret)
+}
+
+type routeDumpIngestor struct{ *dumpStream[routev1.Route] }
+
+func (i *routeDumpIngestor) IngestRoute(ctx context.Context, route types.RouteType) error {
+	return i.add(ctx, route.Namespace, *route)
+}
+
+type groupDumpIngestor struct{ *dumpStream[userv1.Group] }
+
+func (i *groupDumpIngestor) IngestGroup(ctx context.Context, group types.GroupType) error {
+	return i.add(ctx, "", *group)
+}
+
+// dumper tracks the state of a dump operation across all the entity streams.
+type dumper struct {
+	w          DumpWriter
+	log        *log.KubehoundLogger
+	namespaces map[string]struct{}
+	written    map[string]struct{}
+	open       map[*dumpListWriter]struct{}
+	nsFiles    []string
+}
+
+// createList opens a list file at the file collector path corresponding to the namespace and file name.
+func (d *dumper) createList(ctx context.Context, namespace string, file string) (*dumpListWriter, error) {
+	fp := file
+	if namespace != "" {
+		fp = path.Join(namespace, file)
+		d.namespaces[namespace] = struct{}{}
+	}
+
+	d.log.Debugf("Writing dump file %s", fp)
+	w, err := d.w.Create(ctx, fp)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &dumpListWriter{
+		w:    w,
+		buf:  bufio.NewWriter(w),
+		path: fp,
+	}
+
+	if _, err := l.buf.WriteString(dumpListHeader); err != nil {
+		_ = w.Close()
+
+		return nil, fmt.Errorf("writing dump file %s: %w", fp, err)
+	}
+
+	d.written[fp] = struct{}{}
+	d.open[l] = struct{}{}
+
+	return l, nil
+}
+
+// closeList terminates a list file opened by createList.
+func (d *dumper) closeList(l *dumpListWriter) error {
+	delete(d.open, l)
+
+	return l.close()
+}
+
+// abort releases the list files left open by a failed entity stream.
+func (d *dumper) abort() {
+	for l := range d.open {
+		delete(d.open, l)
+		_ = l.w.Close()
+	}
+}
+
+// writeList writes a complete list of objects to the file collector path corresponding to the namespace and file name.
+func (d *dumper) writeList(ctx context.Context, namespace string, file string, items ...any) error {
+	l, err := d.createList(ctx, namespace, file)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := l.add(item); err != nil {
+			d.abort()
+
+			return err
+		}
+	}
+
+	return d.closeList(l)
+}
+
+// writeDiscovery writes the API discovery of the cluster, if available, to the root of the file collector layout.
+func (d *dumper) writeDiscovery(ctx context.Context, client CollectorClient) error {
+	lists, err := client.APIResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(lists) == 0 {
+		d.log.Debugf("No API discovery available, skipping %s", discoveryPath)
+
+		return nil
+	}
+
+	items := make([]any, 0, len(lists))
+	for _, list := range lists {
+		item := *list
+		// Keep the kind in the items to allow the dump to be read as a mixed kind list file
+		item.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: discoveryEntity.kind}
+		items = append(items, item)
+	}
+
+	return d.writeList(ctx, "", discoveryPath, items...)
+}
+
+// emptyList writes an empty list file if the file has not already been written.
+func (d *dumper) emptyList(ctx context.Context, namespace string, file string) error {
+	if _, ok := d.written[path.Join(namespace, file)]; ok {
+		return nil
+	}
+
+	return d.writeList(ctx, namespace, file)
+}
+
+// complete writes empty lists for every file missing from the dump, as expected by the file collector.
+func (d *dumper) complete(ctx context.Context) error {
+	for _, file := range []string{nodePath, namespacePath, clusterRolesPath, clusterRoleBindingsPath} {
+		if err := d.emptyList(ctx, "", file); err != nil {
+			return err
+		}
+	}
+
+	namespaces := make([]string, 0, len(d.namespaces))
+	for ns := range d.namespaces {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	for _, ns := range namespaces {
+		for _, file := range d.nsFiles {
+			if err := d.emptyList(ctx, ns, file); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Dump streams all the K8s objects from the provided collector client and writes them to the dump writer, using the
+// layout expected by the file collector (or the OpenShift file collector if the client supports OpenShift routes).
+// The writer is closed on completion.
+func Dump(ctx context.Context, client CollectorClient, w DumpWriter) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorDump, tracer.Measured())
+	defer span.Finish()
+
+	d := &dumper{
+		w:          w,
+		log:        log.Trace(ctx, log.WithComponent(client.Name())),
+		namespaces: make(map[string]struct{}),
+		written:    make(map[string]struct{}),
+		open:       make(map[*dumpListWriter]struct{}),
+		// Workload controller files are optional for the file collector and only written for non empty lists
+		nsFiles: []string{podPath, rolesPath, roleBindingsPath, endpointPath},
+	}
+
+	streams := []func(ctx context.Context) error{
+		func(ctx context.Context) error {
+			return d.writeDiscovery(ctx, client)
+		},
+		func(ctx context.Context) error {
+			return client.StreamNodes(ctx, &nodeDumpIngestor{newDumpStream[corev1.Node](d, nodePath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamNamespaces(ctx, &namespaceDumpIngestor{newDumpStream[corev1.Namespace](d, namespacePath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamClusterRoles(ctx, &clusterRoleDumpIngestor{newDumpStream[rbacv1.ClusterRole](d, clusterRolesPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamClusterRoleBindings(ctx, &clusterRoleBindingDumpIngestor{newDumpStream[rbacv1.ClusterRoleBinding](d, clusterRoleBindingsPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamPods(ctx, &podDumpIngestor{newDumpStream[corev1.Pod](d, podPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamRoles(ctx, &roleDumpIngestor{newDumpStream[rbacv1.Role](d, rolesPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamRoleBindings(ctx, &roleBindingDumpIngestor{newDumpStream[rbacv1.RoleBinding](d, roleBindingsPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamEndpoints(ctx, &endpointDumpIngestor{newDumpStream[discoveryv1.EndpointSlice](d, endpointPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamServices(ctx, &serviceDumpIngestor{newDumpStream[corev1.Service](d, servicePath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamWorkloads(ctx, newWorkloadDumpIngestor(d))
+		},
+		func(ctx context.Context) error {
+			return client.StreamIngresses(ctx, newIngressDumpIngestor(d))
+		},
+		func(ctx context.Context) error {
+			return client.StreamNetworkPolicies(ctx, &networkPolicyDumpIngestor{newDumpStream[networkingv1.NetworkPolicy](d, networkPolicyPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamSecrets(ctx, &secretDumpIngestor{newDumpStream[corev1.Secret](d, secretPath)})
+		},
+		func(ctx context.Context) error {
+			return client.StreamWebhookConfigurations(ctx, newWebhookConfigurationDumpIngestor(d))
+		},
+		func(ctx context.Context) error {
+			return client.StreamCustomResources(ctx, newCustomResourceDumpIngestor(d))
+		},
+	}
+
+	if osc, ok := client.(OpenShiftCollectorClient); ok {
+		d.nsFiles = append(d.nsFiles, routePath)
+		streams = append(streams, func(ctx context.Context) error {
+			return osc.StreamRoutes(ctx, &routeDumpIngestor{newDumpStream[routev1.Route](d, routePath)})
+		}, func(ctx context.Context) error {
+			return osc.StreamGroups(ctx, &groupDumpIngestor{newDumpStream[userv1.Group](d, groupPath)})
+		})
+	}
+
+	for _, stream := range streams {
+		if err := stream(ctx); err != nil {
+			d.abort()
+			_ = w.Close(ctx)
+
+			return fmt.Errorf("collector dump: %w", err)
+		}
+	}
+
+	if err := d.complete(ctx); err != nil {
+		_ = w.Close(ctx)
+
+		return fmt.Errorf("collector dump: %w", err)
+	}
+
+	return w.Close(ctx)
+}
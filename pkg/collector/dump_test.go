@@ -0,0 +1,218 @@
+package collector
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestDumpClientset() *fake.Clientset {
+	clientset := fake.NewSimpleClientset(
+		[]runtime.Object{
+			fakeNamespace("namespace1", "restricted"),
+			fakeNamespace("namespace2", "privileged"),
+			fakePod("namespace1", "image1"),
+			fakePod("namespace2", "image2"),
+			fakeRole("namespace1", "role1"),
+			fakeRoleBinding("namespace2", "rolebinding1"),
+			fakeNode("node1", "provider1"),
+			fakeClusterRole("clusterrole1"),
+			fakeClusterRoleBinding("clusterrolebinding1"),
+			fakeEndpoint("endpoint1", "namespace1"),
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "daemonset1", Namespace: "namespace2"}},
+			fakeIngress("ingress1", "namespace1"),
+			fakeNetworkPolicy("networkpolicy1", "namespace2"),
+			&admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "mutatingwebhook1"}},
+		}...,
+	)
+
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Verbs: metav1.Verbs{"create", "get", "list"}},
+			},
+		},
+	}
+
+	return clientset
+}
+
+func TestDump_Directory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	w, err := NewDumpWriter(dir)
+	assert.NoError(t, err)
+
+	kc, ok := NewTestK8sAPICollector(ctx, newTestDumpClientset()).(*k8sAPICollector)
+	assert.True(t, ok)
+	kc.metadata = newTestMetadataClient(fakeSecret("secret1", "namespace1"))
+	kc.customResources = []config.CustomResourceConfig{testApplicationResource}
+	appGVR := customResourceGVR(testApplicationResource)
+	dc := newTestDynamicClient()
+	err = dc.Tracker().Create(appGVR, fakeCustomResourceObject(testApplicationResource, "app1", "namespace1"), "namespace1")
+	assert.NoError(t, err)
+	kc.dynamic = dc
+
+	err = Dump(ctx, kc, w)
+	assert.NoError(t, err)
+
+	// Every namespace directory must hold all the namespaced files expected by the file collector
+	for _, ns := range []string{"namespace1", "namespace2"} {
+		for _, f := range []string{podPath, rolesPath, roleBindingsPath, endpointPath} {
+			assert.FileExists(t, filepath.Join(dir, ns, f))
+		}
+	}
+
+	// Read the dump back with the file collector
+	c := &FileCollector{
+		cfg: &config.FileCollectorConfig{
+			Directory:   dir,
+			ClusterName: "test-cluster",
+		},
+		log:             log.Trace(ctx, log.WithComponent(globals.FileCollectorComponent)),
+		customResources: []config.CustomResourceConfig{testApplicationResource},
+	}
+
+	pods := mocks.NewPodIngestor(t)
+	pods.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Twice()
+	pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamPods(ctx, pods))
+
+	roles := mocks.NewRoleIngestor(t)
+	roles.EXPECT().IngestRole(mock.Anything, mock.AnythingOfType("types.RoleType")).Return(nil).Once()
+	roles.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamRoles(ctx, roles))
+
+	nodes := mocks.NewNodeIngestor(t)
+	nodes.EXPECT().IngestNode(mock.Anything, mock.AnythingOfType("types.NodeType")).Return(nil).Once()
+	nodes.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamNodes(ctx, nodes))
+
+	namespaces := mocks.NewNamespaceIngestor(t)
+	namespaces.EXPECT().IngestNamespace(mock.Anything, mock.AnythingOfType("types.NamespaceType")).Return(nil).Twice()
+	namespaces.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamNamespaces(ctx, namespaces))
+
+	workloads := mocks.NewWorkloadIngestor(t)
+	workloads.EXPECT().IngestDaemonSet(mock.Anything, mock.AnythingOfType("types.DaemonSetType")).Return(nil).Once()
+	workloads.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamWorkloads(ctx, workloads))
+
+	endpoints := mocks.NewEndpointIngestor(t)
+	endpoints.EXPECT().IngestEndpoint(mock.Anything, mock.AnythingOfType("types.EndpointType")).Return(nil).Once()
+	endpoints.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamEndpoints(ctx, endpoints))
+
+	ingresses := mocks.NewIngressIngestor(t)
+	ingresses.EXPECT().IngestIngress(mock.Anything, mock.AnythingOfType("types.IngressType")).Return(nil).Once()
+	ingresses.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamIngresses(ctx, ingresses))
+
+	policies := mocks.NewNetworkPolicyIngestor(t)
+	policies.EXPECT().IngestNetworkPolicy(mock.Anything, mock.AnythingOfType("types.NetworkPolicyType")).Return(nil).Once()
+	policies.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamNetworkPolicies(ctx, policies))
+
+	secrets := mocks.NewSecretIngestor(t)
+	secrets.EXPECT().IngestSecret(mock.Anything, mock.AnythingOfType("types.SecretType")).
+		RunAndReturn(func(_ context.Context, secret types.SecretType) error {
+			// The secret data must never be written to the dump
+			assert.Nil(t, secret.Data)
+
+			return nil
+		}).Once()
+	secrets.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamSecrets(ctx, secrets))
+
+	webhooks := mocks.NewWebhookConfigurationIngestor(t)
+	webhooks.EXPECT().IngestMutatingWebhookConfiguration(mock.Anything, mock.AnythingOfType("types.MutatingWebhookConfigurationType")).Return(nil).Once()
+	webhooks.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamWebhookConfigurations(ctx, webhooks))
+
+	crs := mocks.NewCustomResourceIngestor(t)
+	crs.EXPECT().IngestCustomResource(mock.Anything, appGVR, mock.AnythingOfType("types.CustomResourceType")).Return(nil).Once()
+	crs.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamCustomResources(ctx, crs))
+
+	lists, err := c.APIResources(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, lists, 1)
+	assert.Equal(t, "pods", lists[0].APIResources[0].Name)
+}
+
+func TestDump_TarGz(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	output := filepath.Join(t.TempDir(), "dump.tar.gz")
+	w, err := NewDumpWriter(output)
+	assert.NoError(t, err)
+
+	kc, ok := NewTestK8sAPICollector(ctx, newTestDumpClientset()).(*k8sAPICollector)
+	assert.True(t, ok)
+	kc.metadata = newTestMetadataClient(fakeSecret("secret1", "namespace1"))
+
+	err = Dump(ctx, kc, w)
+	assert.NoError(t, err)
+
+	f, err := os.Open(output)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	assert.NoError(t, err)
+
+	files := make(map[string]struct{})
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NoError(t, err)
+
+		if hdr.Typeflag == tar.TypeReg {
+			files[hdr.Name] = struct{}{}
+		}
+	}
+
+	assert.Len(t, files, 4+2*4+6)
+	assert.Contains(t, files, namespacePath)
+	assert.Contains(t, files, nodePath)
+	assert.Contains(t, files, clusterRolesPath)
+	assert.Contains(t, files, clusterRoleBindingsPath)
+	assert.Contains(t, files, "namespace1/"+podPath)
+	assert.Contains(t, files, "namespace2/"+endpointPath)
+	assert.Contains(t, files, "namespace2/"+daemonSetPath)
+	assert.Contains(t, files, "namespace1/"+ingressPath)
+	assert.Contains(t, files, "namespace2/"+networkPolicyPath)
+	assert.Contains(t, files, "namespace1/"+secretPath)
+	assert.Contains(t, files, mutatingWebhookPath)
+	assert.Contains(t, files, discoveryPath)
+
+	// The staged archive entries are removed once appended to the archive
+	entries, err := os.ReadDir(filepath.Dir(output))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,219 @@
+package collector
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// Permissions of files and directories created by the dump writers. K8s data is sensitive so restrict to the owner.
+	dumpFileMode = 0o600
+	dumpDirMode  = 0o700
+)
+
+// DumpWriter abstracts the output of a collector dump, writing files in the file collector layout.
+type DumpWriter interface {
+	// Create opens the file at the relative path within the dump. The file content is written incrementally and
+	// committed to the dump when the returned writer is closed.
+	Create(ctx context.Context, relPath string) (io.WriteCloser, error)
+
+	// Close flushes any buffered data and releases the writer resources.
+	Close(ctx context.Context) error
+}
+
+// NewDumpWriter creates a dump writer for the provided output. Outputs with a .tar.gz (or .tgz) extension are written
+// as a single compressed archive, any other output is treated as a directory. Archive entries are staged next to the
+// output until complete, as the tar format requires the size of each entry upfront.
+func NewDumpWriter(output string) (DumpWriter, error) {
+	if output == "" {
+		return nil, fmt.Errorf("dump output not provided")
+	}
+
+	if strings.HasSuffix(output, ".tar.gz") || strings.HasSuffix(output, ".tgz") {
+		return newTarGzDumpWriter(output)
+	}
+
+	return newDirDumpWriter(output)
+}
+
+// dirDumpWriter writes the dump to a directory on disk.
+type dirDumpWriter struct {
+	root string
+}
+
+func newDirDumpWriter(root string) (*dirDumpWriter, error) {
+	if err := os.MkdirAll(root, dumpDirMode); err != nil {
+		return nil, fmt.Errorf("creating dump directory %s: %w", root, err)
+	}
+
+	return &dirDumpWriter{
+		root: root,
+	}, nil
+}
+
+func (w *dirDumpWriter) Create(_ context.Context, relPath string) (io.WriteCloser, error) {
+	fp := filepath.Join(w.root, filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(fp), dumpDirMode); err != nil {
+		return nil, fmt.Errorf("creating dump directory for %s: %w", fp, err)
+	}
+
+	f, err := os.OpenFile(fp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, dumpFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("creating dump file %s: %w", fp, err)
+	}
+
+	return f, nil
+}
+
+func (w *dirDumpWriter) Close(_ context.Context) error {
+	// NOP for this implementation
+	return nil
+}
+
+// tarGzDumpWriter writes the dump to a single gzip compressed tar archive.
+type tarGzDumpWriter struct {
+	file    *os.File
+	gz      *gzip.Writer
+	tw      *tar.Writer
+	dirs    map[string]struct{}
+	staging string
+}
+
+func newTarGzDumpWriter(output string) (*tarGzDumpWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(output), dumpDirMode); err != nil {
+		return nil, fmt.Errorf("creating dump archive directory: %w", err)
+	}
+
+	f, err := os.OpenFile(output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, dumpFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("creating dump archive %s: %w", output, err)
+	}
+
+	staging, err := os.MkdirTemp(filepath.Dir(output), "."+filepath.Base(output)+"-")
+	if err != nil {
+		_ = f.Close()
+
+		return nil, fmt.Errorf("creating dump archive staging directory: %w", err)
+	}
+
+	gz := gzip.NewWriter(f)
+
+	return &tarGzDumpWriter{
+		file:    f,
+		gz:      gz,
+		tw:      tar.NewWriter(gz),
+		dirs:    make(map[string]struct{}),
+		staging: staging,
+	}, nil
+}
+
+// writeDir adds a directory entry (and its parents) to the archive if not already present.
+func (w *tarGzDumpWriter) writeDir(dir string) error {
+	if dir == "." || dir == "/" {
+		return nil
+	}
+
+	if _, ok := w.dirs[dir]; ok {
+		return nil
+	}
+
+	if err := w.writeDir(path.Dir(dir)); err != nil {
+		return err
+	}
+
+	err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     dir + "/",
+		Mode:     dumpDirMode,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("writing archive directory %s: %w", dir, err)
+	}
+
+	w.dirs[dir] = struct{}{}
+
+	return nil
+}
+
+func (w *tarGzDumpWriter) Create(_ context.Context, relPath string) (io.WriteCloser, error) {
+	f, err := os.CreateTemp(w.staging, "entry-")
+	if err != nil {
+		return nil, fmt.Errorf("staging archive file %s: %w", relPath, err)
+	}
+
+	return &tarGzDumpEntry{
+		File:    f,
+		w:       w,
+		relPath: relPath,
+	}, nil
+}
+
+// writeEntry copies a staged file to the archive.
+func (w *tarGzDumpWriter) writeEntry(relPath string, f *os.File) error {
+	if err := w.writeDir(path.Dir(relPath)); err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("reading staged archive file %s: %w", relPath, err)
+	}
+
+	err = w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     relPath,
+		Mode:     dumpFileMode,
+		Size:     info.Size(),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("writing archive header %s: %w", relPath, err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("reading staged archive file %s: %w", relPath, err)
+	}
+
+	if _, err := io.Copy(w.tw, f); err != nil {
+		return fmt.Errorf("writing archive file %s: %w", relPath, err)
+	}
+
+	return nil
+}
+
+func (w *tarGzDumpWriter) Close(_ context.Context) error {
+	defer os.RemoveAll(w.staging)
+
+	if err := w.tw.Close(); err != nil {
+		return fmt.Errorf("closing dump archive: %w", err)
+	}
+
+	if err := w.gz.Close(); err != nil {
+		return fmt.Errorf("closing dump archive compression: %w", err)
+	}
+
+	return w.file.Close()
+}
+
+// tarGzDumpEntry is a file staged on disk until closed, at which point it is appended to the archive.
+type tarGzDumpEntry struct {
+	*os.File
+	w       *tarGzDumpWriter
+	relPath string
+}
+
+func (e *tarGzDumpEntry) Close() error {
+	defer os.Remove(e.Name())
+	defer e.File.Close()
+
+	return e.w.writeEntry(e.relPath, e.File)
+}
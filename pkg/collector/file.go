@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
-	"path/filepath"
+	"path"
+	"strings"
+	"sync"
 
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals"
@@ -23,23 +26,34 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
-// Expect a file structure of the following
+// Expect either a list of mixed kind list files (see file_list.go) or a file structure of the following
 // |____<namespace>
 // | |____rolebindings.rbac.authorization.k8s.io.json
 // | |____pods.json
 // | |____endpointslices.discovery.k8s.io.json
 // | |____roles.rbac.authorization.k8s.io.json
+// | |____deployments.apps.json (optional, see file_workload.go for all workload controller files)
+// | |____applications.argoproj.io.json (optional, see file_custom_resource.go for the configured custom resources)
+// | |____secrets.json (optional, metadata only, see file_secret.go)
 // |____<namespace>
 // | |____rolebindings.rbac.authorization.k8s.io.json
 // | |____pods.json
 // | |____endpointslices.discovery.k8s.io.json
 // | |____roles.rbac.authorization.k8s.io.json
 // |____nodes.json
+// |____namespaces.json
 // |____clusterroles.rbac.authorization.k8s.io.json
 // |____clusterrolebindings.rbac.authorization.k8s.io.json
+// |____discovery.json (optional, see file_discovery.go)
+// |____groups.user.openshift.io.json (optional, OpenShift only, see file_openshift.go)
+//
+// The structure can be provided as a directory or as a .tar, .tar.gz or .zip archive (optionally nested in a single top
+// level directory). Individual files can also be gzip compressed with an additional .gz extension (e.g pods.json.gz).
 const (
 	nodePath                = "nodes.json"
+	namespacePath           = "namespaces.json"
 	endpointPath            = "endpointslices.discovery.k8s.io.json"
+	servicePath             = "services.json"
 	clusterRolesPath        = "clusterroles.rbac.authorization.k8s.io.json"
 	clusterRoleBindingsPath = "clusterrolebindings.rbac.authorization.k8s.io.json"
 	podPath                 = "pods.json"
@@ -53,9 +67,14 @@ const (
 
 // FileCollector implements a collector based on local K8s API json files generated outside the KubeHound application via e.g kubectl.
 type FileCollector struct {
-	cfg  *config.FileCollectorConfig
-	log  *log.KubehoundLogger
-	tags []string
+	cfg             *config.FileCollectorConfig
+	log             *log.KubehoundLogger
+	tags            []string
+	nsFilter        *namespaceFilter
+	customResources []config.CustomResourceConfig // Custom resources read from the collector files
+
+	sourceOnce sync.Once
+	src        fileSource // Source of the per namespace file structure, shared by all the entity streams
 }
 
 // NewFileCollector creates a new instance of the file collector from the provided application config.
@@ -70,13 +89,29 @@ func NewFileCollector(ctx context.Context, cfg *config.KubehoundConfig) (Collect
 		return nil, errors.New("file collector config not provided")
 	}
 
+	nsFilter, err := newNamespaceFilter(cfg.Collector.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkCustomResourceConfig(cfg.Collector.CustomResources)
+	if err != nil {
+		return nil, err
+	}
+
 	l := log.Trace(ctx, log.WithComponent(globals.FileCollectorComponent))
-	l.Infof("Creating file collector from directory %s", cfg.Collector.File.Directory)
+	if len(cfg.Collector.File.Files) != 0 {
+		l.Infof("Creating file collector from list files %s", strings.Join(cfg.Collector.File.Files, ", "))
+	} else {
+		l.Infof("Creating file collector from directory %s", cfg.Collector.File.Directory)
+	}
 
 	return &FileCollector{
-		cfg:  cfg.Collector.File,
-		log:  l,
-		tags: tags,
+		cfg:             cfg.Collector.File,
+		log:             l,
+		tags:            tags,
+		nsFilter:        nsFilter,
+		customResources: cfg.Collector.CustomResources,
 	}, nil
 }
 
@@ -85,13 +120,21 @@ func (c *FileCollector) Name() string {
 }
 
 func (c *FileCollector) HealthCheck(_ context.Context) (bool, error) {
-	file, err := os.Stat(c.cfg.Directory)
-	if err != nil {
-		return false, fmt.Errorf("file collector base path: %w", err)
-	}
+	if len(c.cfg.Files) != 0 {
+		for _, fp := range c.cfg.Files {
+			if _, err := os.Stat(fp); err != nil {
+				return false, fmt.Errorf("file collector list file: %w", err)
+			}
+		}
+	} else {
+		file, err := os.Stat(c.cfg.Directory)
+		if err != nil {
+			return false, fmt.Errorf("file collector base path: %w", err)
+		}
 
-	if !file.IsDir() {
-		return false, fmt.Errorf("file collector base path is not a directory")
+		if !file.IsDir() && !isArchive(c.cfg.Directory) {
+			return false, fmt.Errorf("file collector base path is not a directory or supported archive")
+		}
 	}
 
 	if c.cfg.ClusterName == "" {
@@ -108,113 +151,161 @@ func (c *FileCollector) ClusterInfo(ctx context.Context) (*ClusterInfo, error) {
 }
 
 func (c *FileCollector) Close(_ context.Context) error {
-	// NOP for this implementation
-	return nil
+	if c.src == nil {
+		return nil
+	}
+
+	return c.src.Close()
 }
 
-// streamPodsNamespace streams the pod objects in a single file, corresponding to a cluster namespace.
-func (c *FileCollector) streamPodsNamespace(ctx context.Context, fp string, ingestor PodIngestor) error {
-	list, err := readList[corev1.PodList](ctx, fp)
-	if err != nil {
-		return err
-	}
+// source returns the source of the per namespace file structure, created on first use so that archives are only
+// opened (and indexed) once for all the entity streams.
+func (c *FileCollector) source() fileSource {
+	c.sourceOnce.Do(func() {
+		c.src = newFileSource(c.cfg.Directory)
+	})
 
-	for _, item := range list.Items {
-		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityPods)), 1)
-		i := item
-		err = ingestor.IngestPod(ctx, &i)
-		if err != nil {
-			return fmt.Errorf("processing K8s pod %s: %w", i.Name, err)
+	return c.src
+}
+
+// walkNamespaceFiles invokes the provided function on every namespace file with the provided name, skipping namespaces
+// excluded by the namespace filter. The namespace is derived from the name of the parent directory of the file.
+func (c *FileCollector) walkNamespaceFiles(ctx context.Context, name string, fn func(fp string, r io.Reader) error) error {
+	match := func(relPath string) bool {
+		dir, file := path.Split(relPath)
+		if file != name || dir == "" {
+			return false
+		}
+
+		if !c.nsFilter.Allowed(path.Base(dir)) {
+			c.log.Debugf("Skipping filtered namespace file %s", relPath)
+
+			return false
 		}
+
+		return true
 	}
 
-	return nil
+	return c.source().Walk(ctx, match, fn)
 }
 
-func (c *FileCollector) StreamPods(ctx context.Context, ingestor PodIngestor) error {
-	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
-	span.SetTag(tag.EntityTag, tag.EntityPods)
-	defer span.Finish()
+// walkClusterFile invokes the provided function on the cluster level file with the provided name.
+// The file is expected at the root of the file structure (or nested in a single top level directory).
+func (c *FileCollector) walkClusterFile(ctx context.Context, name string, fn func(fp string, r io.Reader) error) error {
+	found := false
+	match := func(relPath string) bool {
+		return path.Base(relPath) == name && strings.Count(relPath, "/") <= 1
+	}
 
-	err := filepath.WalkDir(c.cfg.Directory, func(path string, d fs.DirEntry, err error) error {
-		if path == c.cfg.Directory || !d.IsDir() {
-			// Skip files
-			return nil
+	err := c.source().Walk(ctx, match, func(relPath string, r io.Reader) error {
+		found = true
+		if err := fn(relPath, r); err != nil {
+			return err
 		}
 
-		fp := filepath.Join(path, podPath)
-		c.log.Debugf("Streaming pods from file %s", fp)
-
-		return c.streamPodsNamespace(ctx, fp, ingestor)
+		// No need to go through the rest of the files
+		return fs.SkipAll
 	})
-
 	if err != nil {
-		return fmt.Errorf("file collector stream pods: %w", err)
+		return err
 	}
 
-	return ingestor.Complete(ctx)
+	if !found {
+		return fmt.Errorf("read file %s: %w", path.Join(c.cfg.Directory, name), os.ErrNotExist)
+	}
+
+	return nil
 }
 
-// streamRolesNamespace streams the role objects in a single file, corresponding to a cluster namespace.
-func (c *FileCollector) streamRolesNamespace(ctx context.Context, fp string, ingestor RoleIngestor) error {
-	list, err := readList[rbacv1.RoleList](ctx, fp)
-	if err != nil {
-		return err
+// fileEntity describes how the objects of a K8s entity type are stored in the collector files.
+type fileEntity struct {
+	path       string // File name in the per namespace file structure
+	group      string // Object API group in the mixed kind list files (empty for the core group)
+	kind       string // Object kind in the mixed kind list files
+	namespaced bool   // Whether the objects are stored in per namespace directories
+}
+
+var (
+	podEntity                = fileEntity{path: podPath, kind: "Pod", namespaced: true}
+	roleEntity               = fileEntity{path: rolesPath, group: rbacv1.GroupName, kind: "Role", namespaced: true}
+	roleBindingEntity        = fileEntity{path: roleBindingsPath, group: rbacv1.GroupName, kind: "RoleBinding", namespaced: true}
+	endpointEntity           = fileEntity{path: endpointPath, group: discoveryv1.GroupName, kind: "EndpointSlice", namespaced: true}
+	serviceEntity            = fileEntity{path: servicePath, kind: "Service", namespaced: true}
+	nodeEntity               = fileEntity{path: nodePath, kind: "Node"}
+	namespaceEntity          = fileEntity{path: namespacePath, kind: "Namespace"}
+	clusterRoleEntity        = fileEntity{path: clusterRolesPath, group: rbacv1.GroupName, kind: "ClusterRole"}
+	clusterRoleBindingEntity = fileEntity{path: clusterRoleBindingsPath, group: rbacv1.GroupName, kind: "ClusterRoleBinding"}
+)
+
+// streamFileEntity streams all the objects of an entity type to the provided function, either from the per namespace
+// file structure or from the mixed kind list files depending on the collector configuration.
+func streamFileEntity[T types.ListItemInputType](ctx context.Context, c *FileCollector, e fileEntity, fn func(*T) error) error {
+	if len(c.cfg.Files) != 0 {
+		return c.walkListFilesKind(ctx, e.group, e.kind, func(fp string, raw []byte) error {
+			item := new(T)
+			if err := json.Unmarshal(raw, item); err != nil {
+				return fmt.Errorf("unmarshalling %T json %s: %w", *item, fp, err)
+			}
+
+			return fn(item)
+		})
 	}
 
-	for _, item := range list.Items {
-		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityRoles)), 1)
-		i := item
-		err = ingestor.IngestRole(ctx, &i)
-		if err != nil {
-			return fmt.Errorf("processing K8s role %s: %w", i.Name, err)
-		}
+	streamFile := func(fp string, r io.Reader) error {
+		c.log.Debugf("Streaming %s objects from file %s", e.kind, fp)
+
+		return streamList(ctx, fp, r, fn)
 	}
 
-	return nil
+	if e.namespaced {
+		return c.walkNamespaceFiles(ctx, e.path, streamFile)
+	}
+
+	return c.walkClusterFile(ctx, e.path, streamFile)
 }
 
-func (c *FileCollector) StreamRoles(ctx context.Context, ingestor RoleIngestor) error {
+func (c *FileCollector) StreamPods(ctx context.Context, ingestor PodIngestor) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
-	span.SetTag(tag.EntityTag, tag.EntityRoles)
+	span.SetTag(tag.EntityTag, tag.EntityPods)
 	defer span.Finish()
 
-	err := filepath.WalkDir(c.cfg.Directory, func(path string, d fs.DirEntry, err error) error {
-		if path == c.cfg.Directory || !d.IsDir() {
-			// Skip files
-			return nil
+	err := streamFileEntity(ctx, c, podEntity, func(item *corev1.Pod) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityPods)), 1)
+		err := ingestor.IngestPod(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s pod %s: %w", item.Name, err)
 		}
 
-		f := filepath.Join(path, rolesPath)
-		c.log.Debugf("Streaming roles from file %s", f)
-
-		return c.streamRolesNamespace(ctx, f, ingestor)
+		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("file collector stream roles: %w", err)
+		return fmt.Errorf("file collector stream pods: %w", err)
 	}
 
 	return ingestor.Complete(ctx)
 }
 
-// streamRoleBindingsNamespace streams the role bindings objects in a single file, corresponding to a cluster namespace.
-func (c *FileCollector) streamRoleBindingsNamespace(ctx context.Context, fp string, ingestor RoleBindingIngestor) error {
-	list, err := readList[rbacv1.RoleBindingList](ctx, fp)
-	if err != nil {
-		return err
-	}
+func (c *FileCollector) StreamRoles(ctx context.Context, ingestor RoleIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityRoles)
+	defer span.Finish()
 
-	for _, item := range list.Items {
-		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityRolebindings)), 1)
-		i := item
-		err = ingestor.IngestRoleBinding(ctx, &i)
+	err := streamFileEntity(ctx, c, roleEntity, func(item *rbacv1.Role) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityRoles)), 1)
+		err := ingestor.IngestRole(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing K8s role binding %s: %w", i.Name, err)
+			return fmt.Errorf("processing K8s role %s: %w", item.Name, err)
 		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("file collector stream roles: %w", err)
 	}
 
-	return nil
+	return ingestor.Complete(ctx)
 }
 
 func (c *FileCollector) StreamRoleBindings(ctx context.Context, ingestor RoleBindingIngestor) error {
@@ -222,16 +313,14 @@ func (c *FileCollector) StreamRoleBindings(ctx context.Context, ingestor RoleBin
 	span.SetTag(tag.EntityTag, tag.EntityRolebindings)
 	defer span.Finish()
 
-	err := filepath.WalkDir(c.cfg.Directory, func(path string, d fs.DirEntry, err error) error {
-		if path == c.cfg.Directory || !d.IsDir() {
-			// Skip files
-			return nil
+	err := streamFileEntity(ctx, c, roleBindingEntity, func(item *rbacv1.RoleBinding) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityRolebindings)), 1)
+		err := ingestor.IngestRoleBinding(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s role binding %s: %w", item.Name, err)
 		}
 
-		fp := filepath.Join(path, roleBindingsPath)
-		c.log.Debugf("Streaming role bindings from file %s", fp)
-
-		return c.streamRoleBindingsNamespace(ctx, fp, ingestor)
+		return nil
 	})
 
 	if err != nil {
@@ -241,44 +330,45 @@ func (c *FileCollector) StreamRoleBindings(ctx context.Context, ingestor RoleBin
 	return ingestor.Complete(ctx)
 }
 
-// streamEndpointsNamespace streams the endpoint slices in a single file, corresponding to a cluster namespace.
-func (c *FileCollector) streamEndpointsNamespace(ctx context.Context, fp string, ingestor EndpointIngestor) error {
-	list, err := readList[discoveryv1.EndpointSliceList](ctx, fp)
-	if err != nil {
-		return err
-	}
+func (c *FileCollector) StreamEndpoints(ctx context.Context, ingestor EndpointIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityEndpoints)
+	defer span.Finish()
 
-	for _, item := range list.Items {
+	err := streamFileEntity(ctx, c, endpointEntity, func(item *discoveryv1.EndpointSlice) error {
 		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityEndpoints)), 1)
-		i := item
-		err = ingestor.IngestEndpoint(ctx, &i)
+		err := ingestor.IngestEndpoint(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing K8s endpoint slice %s: %w", i.Name, err)
+			return fmt.Errorf("processing K8s endpoint slice %s: %w", item.Name, err)
 		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("file collector stream endpoint slices: %w", err)
 	}
 
-	return nil
+	return ingestor.Complete(ctx)
 }
 
-func (c *FileCollector) StreamEndpoints(ctx context.Context, ingestor EndpointIngestor) error {
+func (c *FileCollector) StreamServices(ctx context.Context, ingestor ServiceIngestor) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
-	span.SetTag(tag.EntityTag, tag.EntityEndpoints)
+	span.SetTag(tag.EntityTag, tag.EntityServices)
 	defer span.Finish()
 
-	err := filepath.WalkDir(c.cfg.Directory, func(path string, d fs.DirEntry, err error) error {
-		if path == c.cfg.Directory || !d.IsDir() {
-			// Skip files
-			return nil
+	err := streamFileEntity(ctx, c, serviceEntity, func(item *corev1.Service) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityServices)), 1)
+		err := ingestor.IngestService(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s service %s: %w", item.Name, err)
 		}
 
-		fp := filepath.Join(path, endpointPath)
-		c.log.Debugf("Streaming endpoint slices from file %s", fp)
-
-		return c.streamEndpointsNamespace(ctx, fp, ingestor)
+		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("file collector stream endpoint slices: %w", err)
+		return fmt.Errorf("file collector stream services: %w", err)
 	}
 
 	return ingestor.Complete(ctx)
@@ -289,21 +379,17 @@ func (c *FileCollector) StreamNodes(ctx context.Context, ingestor NodeIngestor)
 	span.SetTag(tag.EntityTag, tag.EntityNodes)
 	defer span.Finish()
 
-	fp := filepath.Join(c.cfg.Directory, nodePath)
-	c.log.Debugf("Streaming nodes from file %s", fp)
-
-	list, err := readList[corev1.NodeList](ctx, fp)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range list.Items {
+	err := streamFileEntity(ctx, c, nodeEntity, func(item *corev1.Node) error {
 		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityNodes)), 1)
-		i := item
-		err = ingestor.IngestNode(ctx, &i)
+		err := ingestor.IngestNode(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing K8s node %s::%s: %w", i.Namespace, i.Name, err)
+			return fmt.Errorf("processing K8s node %s::%s: %w", item.Namespace, item.Name, err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return ingestor.Complete(ctx)
@@ -314,21 +400,17 @@ func (c *FileCollector) StreamClusterRoles(ctx context.Context, ingestor Cluster
 	span.SetTag(tag.EntityTag, tag.EntityClusterRoles)
 	defer span.Finish()
 
-	fp := filepath.Join(c.cfg.Directory, clusterRolesPath)
-	c.log.Debugf("Streaming cluster roles from file %s", fp)
-
-	list, err := readList[rbacv1.ClusterRoleList](ctx, fp)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range list.Items {
+	err := streamFileEntity(ctx, c, clusterRoleEntity, func(item *rbacv1.ClusterRole) error {
 		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityClusterRoles)), 1)
-		i := item
-		err = ingestor.IngestClusterRole(ctx, &i)
+		err := ingestor.IngestClusterRole(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing k8s cluster role %s: %w", i.Name, err)
+			return fmt.Errorf("processing k8s cluster role %s: %w", item.Name, err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return ingestor.Complete(ctx)
@@ -339,46 +421,139 @@ func (c *FileCollector) StreamClusterRoleBindings(ctx context.Context, ingestor
 	span.SetTag(tag.EntityTag, tag.EntityClusterRolebindings)
 	defer span.Finish()
 
-	fp := filepath.Join(c.cfg.Directory, clusterRoleBindingsPath)
-	c.log.Debugf("Streaming cluster role bindings from file %s", fp)
+	err := streamFileEntity(ctx, c, clusterRoleBindingEntity, func(item *rbacv1.ClusterRoleBinding) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityClusterRolebindings)), 1)
+		err := ingestor.IngestClusterRoleBinding(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s cluster role binding %s: %w", item.Name, err)
+		}
 
-	list, err := readList[rbacv1.ClusterRoleBindingList](ctx, fp)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, item := range list.Items {
-		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityClusterRolebindings)), 1)
-		i := item
-		err = ingestor.IngestClusterRoleBinding(ctx, &i)
+	return ingestor.Complete(ctx)
+}
+
+func (c *FileCollector) StreamNamespaces(ctx context.Context, ingestor NamespaceIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityNamespaces)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, namespaceEntity, func(item *corev1.Namespace) error {
+		// Namespaces are cluster scoped objects, filtered on their own name
+		if !c.nsFilter.Allowed(item.Name) {
+			return nil
+		}
+
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityNamespaces)), 1)
+		err := ingestor.IngestNamespace(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing K8s cluster role binding %s: %w", i.Name, err)
+			return fmt.Errorf("processing K8s namespace %s: %w", item.Name, err)
 		}
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		// Namespaces were not part of the file structure of earlier versions, the data can still be processed without
+		c.log.Warnf("No namespaces file found, skipping namespace ingestion: %v", err)
+	} else if err != nil {
+		return err
 	}
 
 	return ingestor.Complete(ctx)
 }
 
-// readList loads a list of K8s API objects into memory from a JSON file on disk.
-// NOTE: This implementation reads the entire array of objects from the file into memory at once.
-func readList[Tl types.ListInputType](ctx context.Context, inputPath string) (Tl, error) {
+// streamList decodes a list of K8s API objects from a JSON file, invoking the provided function on each item of the
+// list as soon as it is decoded. Only a single item is held in memory at a time, regardless of the size of the list.
+func streamList[T types.ListItemInputType](ctx context.Context, inputPath string, r io.Reader, fn func(*T) error) error {
+	return decodeList(ctx, inputPath, r, func(dec *json.Decoder) error {
+		item := new(T)
+		if err := dec.Decode(item); err != nil {
+			return fmt.Errorf("unmarshalling %T json %s: %w", *item, inputPath, err)
+		}
+
+		return fn(item)
+	})
+}
+
+// decodeList walks the JSON tokens of a list of K8s API objects, invoking the provided item decoder for each entry of
+// the items array. Any other field of the list is skipped.
+func decodeList(ctx context.Context, inputPath string, r io.Reader, decodeItem func(*json.Decoder) error) error {
 	span, _ := tracer.StartSpanFromContext(ctx, span.CollectorReadFile, tracer.Measured())
 	defer span.Finish()
 
-	var inputList Tl
-	bytes, err := os.ReadFile(inputPath)
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if errors.Is(err, io.EOF) {
+		// Empty file
+		return nil
+	}
+
 	if err != nil {
-		return inputList, fmt.Errorf("read file %s: %w", inputPath, err)
+		return fmt.Errorf("read file %s: %w", inputPath, err)
 	}
 
-	if len(bytes) == 0 {
-		return inputList, nil
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("unmarshalling list json %s: expected object, got %v", inputPath, tok)
 	}
 
-	err = json.Unmarshal(bytes, &inputList)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("unmarshalling list json %s: %w", inputPath, err)
+		}
+
+		if key, ok := tok.(string); !ok || key != "items" {
+			// Skip the value of any other list field (apiVersion, kind, metadata, ...)
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("unmarshalling list json %s: %w", inputPath, err)
+			}
+
+			continue
+		}
+
+		if err := decodeListItems(ctx, dec, inputPath, decodeItem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeListItems decodes the items array of a list of K8s API objects one item at a time.
+func decodeListItems(ctx context.Context, dec *json.Decoder, inputPath string, decodeItem func(*json.Decoder) error) error {
+	tok, err := dec.Token()
 	if err != nil {
-		return inputList, fmt.Errorf("unmarshalling %T json: %w", inputList, err)
+		return fmt.Errorf("unmarshalling items json %s: %w", inputPath, err)
+	}
+
+	if tok == nil {
+		// null items
+		return nil
 	}
 
-	return inputList, nil
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("unmarshalling items json %s: expected array, got %v", inputPath, tok)
+	}
+
+	for dec.More() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := decodeItem(dec); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing bracket of the array
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("unmarshalling items json %s: %w", inputPath, err)
+	}
+
+	return nil
 }
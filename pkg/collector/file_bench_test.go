@@ -0,0 +1,110 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	benchmarkPodCount = 10000
+)
+
+// readListReference is the previous implementation of the file collector list decoding, loading the entire list into
+// memory before processing any item. Kept as a baseline for the streaming decoder benchmarks.
+func readListReference[Tl types.ListInputType](r io.Reader) (Tl, error) {
+	var inputList Tl
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return inputList, err
+	}
+
+	if len(bytes) == 0 {
+		return inputList, nil
+	}
+
+	err = json.Unmarshal(bytes, &inputList)
+
+	return inputList, err
+}
+
+func benchmarkPodList(b *testing.B) []byte {
+	b.Helper()
+
+	list := corev1.PodList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "List",
+		},
+		Items: make([]corev1.Pod, 0, benchmarkPodCount),
+	}
+
+	for i := 0; i < benchmarkPodCount; i++ {
+		pod := fakePod(fmt.Sprintf("namespace%d", i%10), fmt.Sprintf("image%d", i))
+		pod.Name = fmt.Sprintf("pod-%d", i)
+		pod.Labels = map[string]string{"app": "benchmark", "index": fmt.Sprint(i)}
+		list.Items = append(list.Items, *pod)
+	}
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		b.Fatalf("marshalling benchmark pod list: %v", err)
+	}
+
+	return data
+}
+
+func BenchmarkReadList(b *testing.B) {
+	data := benchmarkPodList(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		list, err := readListReference[corev1.PodList](bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		count := 0
+		for i := range list.Items {
+			_ = &list.Items[i]
+			count++
+		}
+
+		if count != benchmarkPodCount {
+			b.Fatalf("unexpected pod count: %d", count)
+		}
+	}
+}
+
+func BenchmarkStreamList(b *testing.B) {
+	data := benchmarkPodList(b)
+	ctx := context.Background()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		count := 0
+		err := streamList(ctx, "benchmark.json", bytes.NewReader(data), func(_ *corev1.Pod) error {
+			count++
+
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if count != benchmarkPodCount {
+			b.Fatalf("unexpected pod count: %d", count)
+		}
+	}
+}
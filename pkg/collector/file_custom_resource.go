@@ -0,0 +1,92 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// customResourcePath returns the name of the files holding the objects of a custom resource, named after the resource
+// qualified by its API group (e.g applications.argoproj.io.json). Namespaced objects are stored in the namespace
+// directories and cluster scoped objects at the root of the file structure. The files are optional.
+func customResourcePath(gr schema.GroupResource) string {
+	return gr.String() + ".json"
+}
+
+// walkCustomResource invokes the provided function on every object of a configured custom resource, either from the
+// file structure or from the mixed kind list files depending on the collector configuration. The namespace filter is
+// applied on the namespace of the objects as the files of namespaced and cluster scoped objects share the same name.
+func (c *FileCollector) walkCustomResource(ctx context.Context, cr config.CustomResourceConfig,
+	fn func(*unstructured.Unstructured) error) error {
+
+	if len(c.cfg.Files) != 0 {
+		if cr.Kind == "" {
+			c.log.Warnf("No kind configured for custom resource %s, skipping its collection from list files", cr)
+
+			return nil
+		}
+
+		return c.walkListFilesKind(ctx, cr.Group, cr.Kind, func(fp string, raw []byte) error {
+			item := &unstructured.Unstructured{}
+			if err := json.Unmarshal(raw, item); err != nil {
+				return fmt.Errorf("unmarshalling %s json %s: %w", cr, fp, err)
+			}
+
+			return fn(item)
+		})
+	}
+
+	name := customResourcePath(customResourceGVR(cr).GroupResource())
+
+	match := func(relPath string) bool {
+		return path.Base(relPath) == name
+	}
+
+	return c.source().Walk(ctx, match, func(relPath string, r io.Reader) error {
+		c.log.Debugf("Streaming %s objects from file %s", cr, relPath)
+
+		return streamList(ctx, relPath, r, func(item *unstructured.Unstructured) error {
+			if !c.nsFilter.Allowed(item.GetNamespace()) {
+				return nil
+			}
+
+			return fn(item)
+		})
+	})
+}
+
+// StreamCustomResources streams the objects of all the configured custom resources.
+func (c *FileCollector) StreamCustomResources(ctx context.Context, ingestor CustomResourceIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityCustomResources)
+	defer span.Finish()
+
+	for _, cr := range c.customResources {
+		gvr := customResourceGVR(cr)
+		err := c.walkCustomResource(ctx, cr, func(item *unstructured.Unstructured) error {
+			_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(cr.String())), 1)
+			err := ingestor.IngestCustomResource(ctx, gvr, item)
+			if err != nil {
+				return fmt.Errorf("processing K8s %s %s: %w", cr, item.GetName(), err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("file collector stream custom resources %s: %w", cr, err)
+		}
+	}
+
+	return ingestor.Complete(ctx)
+}
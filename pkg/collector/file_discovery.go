@@ -0,0 +1,46 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The API discovery of the cluster is stored at the root of the file structure as a list of APIResourceList objects
+// (one per group version). The file is optional as it was not part of the file structure of earlier versions.
+const (
+	discoveryPath = "discovery.json"
+)
+
+var (
+	discoveryEntity = fileEntity{path: discoveryPath, kind: "APIResourceList"}
+)
+
+// APIResources returns the resources served by the cluster from the captured API discovery, or nil if the discovery
+// was not captured.
+func (c *FileCollector) APIResources(ctx context.Context) ([]*metav1.APIResourceList, error) {
+	lists := make([]*metav1.APIResourceList, 0)
+	err := streamFileEntity(ctx, c, discoveryEntity, func(item *metav1.APIResourceList) error {
+		lists = append(lists, item)
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		c.log.Debugf("No API discovery file found, skipping: %v", err)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("file collector discovery: %w", err)
+	}
+
+	if len(lists) == 0 {
+		return nil, nil
+	}
+
+	return lists, nil
+}
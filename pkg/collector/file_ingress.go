@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// Traffic entry points are stored in the per namespace file structure. The files are optional as they were not part of
+// the file structure of earlier versions.
+const (
+	ingressPath   = "ingresses.networking.k8s.io.json"
+	gatewayPath   = "gateways.gateway.networking.k8s.io.json"
+	httpRoutePath = "httproutes.gateway.networking.k8s.io.json"
+)
+
+var (
+	ingressEntity   = fileEntity{path: ingressPath, group: networkingv1.GroupName, kind: "Ingress", namespaced: true}
+	gatewayEntity   = fileEntity{path: gatewayPath, group: gatewayapi.GroupName, kind: "Gateway", namespaced: true}
+	httpRouteEntity = fileEntity{path: httpRoutePath, group: gatewayapi.GroupName, kind: "HTTPRoute", namespaced: true}
+)
+
+// StreamIngresses streams the ingresses, Gateway API gateways and HTTP routes of all namespaces. Gateways are streamed
+// before the HTTP routes attached to them.
+func (c *FileCollector) StreamIngresses(ctx context.Context, ingestor IngressIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityIngresses)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, ingressEntity, func(item *networkingv1.Ingress) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityIngresses)), 1)
+		err := ingestor.IngestIngress(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s ingress %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream ingresses: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, gatewayEntity, func(item *gatewayapi.Gateway) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityGateways)), 1)
+		err := ingestor.IngestGateway(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s gateway %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream gateways: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, httpRouteEntity, func(item *gatewayapi.HTTPRoute) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityHTTPRoutes)), 1)
+		err := ingestor.IngestHTTPRoute(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s HTTP route %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream HTTP routes: %w", err)
+	}
+
+	return ingestor.Complete(ctx)
+}
@@ -0,0 +1,153 @@
+package collector
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Mixed kind list files, as generated by e.g kubectl get pods,roles,rolebindings -A -o json.
+// JSON files must hold a single List object, while YAML files (.yaml or .yml extension) can hold multiple documents,
+// each being either a List or a single K8s object. Files can be gzip compressed with an additional .gz extension.
+
+// listItemHeader holds the fields required to route a list item to the matching entity stream.
+type listItemHeader struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+}
+
+// yamlDocumentHeader holds the fields required to identify whether a YAML document is a list or a single object.
+type yamlDocumentHeader struct {
+	Kind  string            `json:"kind"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// walkListFilesKind invokes the provided function with the raw JSON of every item of the configured list files matching
+// the provided API group (empty for the core group) and kind, skipping namespaces excluded by the namespace filter.
+// Both are required as different API groups can define the same kind (e.g Istio and Gateway API gateways).
+// NOTE: The list files are read in full for each kind, i.e each entity stream.
+func (c *FileCollector) walkListFilesKind(ctx context.Context, group, kind string,
+	fn func(fp string, raw []byte) error) error {
+
+	for _, fp := range c.cfg.Files {
+		err := walkListFile(ctx, fp, func(raw []byte) error {
+			var header listItemHeader
+			if err := json.Unmarshal(raw, &header); err != nil {
+				return fmt.Errorf("unmarshalling list item json %s: %w", fp, err)
+			}
+
+			gv, err := schema.ParseGroupVersion(header.APIVersion)
+			if err != nil {
+				return fmt.Errorf("parsing list item api version %s: %w", fp, err)
+			}
+
+			if header.Kind != kind || gv.Group != group {
+				return nil
+			}
+
+			if !c.nsFilter.Allowed(header.Metadata.Namespace) {
+				return nil
+			}
+
+			return fn(fp, raw)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkListFile invokes the provided function with the raw JSON of every item of a single list file.
+func walkListFile(ctx context.Context, fp string, fn func(raw []byte) error) error {
+	f, err := os.Open(fp)
+	if err != nil {
+		return fmt.Errorf("open list file %s: %w", fp, err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	name := fp
+	if strings.HasSuffix(name, gzipExtension) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("opening compressed list file %s: %w", fp, err)
+		}
+		defer gz.Close()
+
+		r = gz
+		name = strings.TrimSuffix(name, gzipExtension)
+	}
+
+	if strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml") {
+		return walkYAMLListFile(ctx, fp, r, fn)
+	}
+
+	return decodeList(ctx, fp, r, func(dec *json.Decoder) error {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("unmarshalling list item json %s: %w", fp, err)
+		}
+
+		return fn(raw)
+	})
+}
+
+// walkYAMLListFile invokes the provided function with the raw JSON of every object of a multi document YAML file.
+// Each document can either be a list or a single object.
+func walkYAMLListFile(ctx context.Context, fp string, r io.Reader, fn func(raw []byte) error) error {
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("read yaml document %s: %w", fp, err)
+		}
+
+		raw, err := yaml.ToJSON(doc)
+		if err != nil {
+			return fmt.Errorf("converting yaml document %s: %w", fp, err)
+		}
+
+		var header yamlDocumentHeader
+		if err := json.Unmarshal(raw, &header); err != nil {
+			return fmt.Errorf("unmarshalling yaml document %s: %w", fp, err)
+		}
+
+		switch {
+		case header.Kind == "":
+			// Empty document
+			continue
+		case header.Kind == "List" || strings.HasSuffix(header.Kind, "List"):
+			for _, item := range header.Items {
+				if err := fn(item); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := fn(raw); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+//nolint:forcetypeassert
+package collector
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func NewTestListFileCollector(t *testing.T) *FileCollector {
+	t.Helper()
+
+	cfg, err := config.NewConfig("testdata/kubehound-test-list.yaml")
+	assert.NoError(t, err)
+
+	c, err := NewFileCollector(context.Background(), cfg)
+	assert.NoError(t, err)
+
+	return c.(*FileCollector)
+}
+
+func TestListFileCollector_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+	ok, err := c.HealthCheck(context.Background())
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	c = &FileCollector{
+		cfg: &config.FileCollectorConfig{
+			Files:       []string{"testdata/does-not-exist.json"},
+			ClusterName: "test-cluster",
+		},
+	}
+
+	ok, err = c.HealthCheck(context.Background())
+	assert.False(t, ok)
+	assert.ErrorContains(t, err, "no such file or directory")
+}
+
+func TestListFileCollector_Stream(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+	ctx := context.Background()
+
+	pods := mocks.NewPodIngestor(t)
+	pods.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Twice()
+	pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamPods(ctx, pods))
+
+	roles := mocks.NewRoleIngestor(t)
+	roles.EXPECT().IngestRole(mock.Anything, mock.AnythingOfType("types.RoleType")).Return(nil).Twice()
+	roles.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamRoles(ctx, roles))
+
+	rbs := mocks.NewRoleBindingIngestor(t)
+	rbs.EXPECT().IngestRoleBinding(mock.Anything, mock.AnythingOfType("types.RoleBindingType")).Return(nil).Twice()
+	rbs.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamRoleBindings(ctx, rbs))
+
+	endpoints := mocks.NewEndpointIngestor(t)
+	endpoints.EXPECT().IngestEndpoint(mock.Anything, mock.AnythingOfType("types.EndpointType")).Return(nil).Once()
+	endpoints.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamEndpoints(ctx, endpoints))
+
+	// Cluster scoped objects are held in the multi document YAML file
+	nodes := mocks.NewNodeIngestor(t)
+	nodes.EXPECT().IngestNode(mock.Anything, mock.AnythingOfType("types.NodeType")).Return(nil).Once()
+	nodes.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamNodes(ctx, nodes))
+
+	crs := mocks.NewClusterRoleIngestor(t)
+	crs.EXPECT().IngestClusterRole(mock.Anything, mock.AnythingOfType("types.ClusterRoleType")).Return(nil).Once()
+	crs.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamClusterRoles(ctx, crs))
+
+	crbs := mocks.NewClusterRoleBindingIngestor(t)
+	crbs.EXPECT().IngestClusterRoleBinding(mock.Anything, mock.AnythingOfType("types.ClusterRoleBindingType")).Return(nil).Once()
+	crbs.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamClusterRoleBindings(ctx, crbs))
+}
+
+func TestListFileCollector_APIResources(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+
+	lists, err := c.APIResources(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, lists, 1)
+	assert.Equal(t, "v1", lists[0].GroupVersion)
+	assert.Equal(t, "pods", lists[0].APIResources[0].Name)
+}
+
+func TestListFileCollector_StreamCustomResources(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+	c.customResources = []config.CustomResourceConfig{testApplicationResource}
+	ctx := context.Background()
+
+	// Objects of the same kind from a different API group are ignored
+	apps := mocks.NewCustomResourceIngestor(t)
+	apps.EXPECT().IngestCustomResource(mock.Anything, customResourceGVR(testApplicationResource), mock.AnythingOfType("types.CustomResourceType")).Return(nil).Once()
+	apps.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamCustomResources(ctx, apps))
+
+	// The kind is required to read the custom resources from list files
+	c.customResources = []config.CustomResourceConfig{{Group: "argoproj.io", Version: "v1alpha1", Resource: "applications"}}
+	apps = mocks.NewCustomResourceIngestor(t)
+	apps.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamCustomResources(ctx, apps))
+}
+
+func TestListFileCollector_StreamIngresses(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+	ctx := context.Background()
+
+	// Objects of the same kind from a different API group (Istio gateway) are ignored
+	i := mocks.NewIngressIngestor(t)
+	i.EXPECT().IngestGateway(mock.Anything, mock.MatchedBy(func(gw types.GatewayType) bool {
+		return gw.Name == "test-gateway"
+	})).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamIngresses(ctx, i))
+}
+
+func TestListFileCollector_NamespaceFilter(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestListFileCollector(t)
+	nsFilter, err := newNamespaceFilter(&config.NamespaceFilterConfig{
+		Exclude: []string{"test-*"},
+	})
+	assert.NoError(t, err)
+	c.nsFilter = nsFilter
+
+	ctx := context.Background()
+	pods := mocks.NewPodIngestor(t)
+	pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamPods(ctx, pods))
+
+	// Cluster scoped objects are never filtered
+	nodes := mocks.NewNodeIngestor(t)
+	nodes.EXPECT().IngestNode(mock.Anything, mock.AnythingOfType("types.NodeType")).Return(nil).Once()
+	nodes.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamNodes(ctx, nodes))
+}
@@ -0,0 +1,45 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// Network policies are stored in the per namespace file structure. The files are optional as they were not part of the
+// file structure of earlier versions.
+const (
+	networkPolicyPath = "networkpolicies.networking.k8s.io.json"
+)
+
+var (
+	networkPolicyEntity = fileEntity{path: networkPolicyPath, group: networkingv1.GroupName, kind: "NetworkPolicy", namespaced: true}
+)
+
+// StreamNetworkPolicies streams the network policies of all namespaces.
+func (c *FileCollector) StreamNetworkPolicies(ctx context.Context, ingestor NetworkPolicyIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityNetworkPolicies)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, networkPolicyEntity, func(item *networkingv1.NetworkPolicy) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityNetworkPolicies)), 1)
+		err := ingestor.IngestNetworkPolicy(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s network policy %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream network policies: %w", err)
+	}
+
+	return ingestor.Complete(ctx)
+}
@@ -2,7 +2,10 @@ package collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals"
 	"github.com/DataDog/KubeHound/pkg/telemetry/log"
@@ -11,13 +14,20 @@ import (
 	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
 	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
 	routev1 "github.com/openshift/api/route/v1"
+	userv1 "github.com/openshift/api/user/v1"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
-	"io/fs"
-	"path/filepath"
 )
 
 const (
 	routePath = "routes.route.openshift.io.json"
+
+	// Groups are cluster scoped and stored at the root of the file structure.
+	groupPath = "groups.user.openshift.io.json"
+)
+
+var (
+	routeEntity = fileEntity{path: routePath, group: routev1.GroupName, kind: "Route", namespaced: true}
+	groupEntity = fileEntity{path: groupPath, group: userv1.GroupName, kind: "Group"}
 )
 
 const (
@@ -35,14 +45,26 @@ func NewOpenShiftFileCollector(ctx context.Context, cfg *config.KubehoundConfig)
 		return nil, fmt.Errorf("invalid collector type in config: %s", cfg.Collector.Type)
 	}
 
+	nsFilter, err := newNamespaceFilter(cfg.Collector.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkCustomResourceConfig(cfg.Collector.CustomResources)
+	if err != nil {
+		return nil, err
+	}
+
 	l := log.Trace(ctx, log.WithComponent(globals.FileCollectorComponent))
 	l.Infof("Creating file collector from directory %s", cfg.Collector.File.Directory)
 
 	return &openShiftFileCollector{
 		FileCollector: &FileCollector{
-			cfg:  cfg.Collector.File,
-			log:  l,
-			tags: tags,
+			cfg:             cfg.Collector.File,
+			log:             l,
+			tags:            tags,
+			nsFilter:        nsFilter,
+			customResources: cfg.Collector.CustomResources,
 		},
 	}, nil
 }
@@ -51,43 +73,46 @@ func (c *openShiftFileCollector) Name() string {
 	return FileOpenshiftCollectorName
 }
 
-func (c *openShiftFileCollector) streamRoutesNamespace(ctx context.Context, fp string, ingestor RouteIngestor) error {
-	list, err := readList[routev1.RouteList](ctx, fp)
-	if err != nil {
-		return err
-	}
+func (c *openShiftFileCollector) StreamRoutes(ctx context.Context, ingestor RouteIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityRoutes)
+	defer span.Finish()
 
-	for _, item := range list.Items {
+	err := streamFileEntity(ctx, c.FileCollector, routeEntity, func(item *routev1.Route) error {
 		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityRoutes)), 1)
-		i := item
-		err = ingestor.IngestRoute(ctx, &i)
+		err := ingestor.IngestRoute(ctx, item)
 		if err != nil {
-			return fmt.Errorf("processing OpenShift route %s: %w", i.Name, err)
+			return fmt.Errorf("processing OpenShift route %s: %w", item.Name, err)
 		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("file collector stream routes: %w", err)
 	}
 
-	return nil
+	return ingestor.Complete(ctx)
 }
 
-func (c *openShiftFileCollector) StreamRoutes(ctx context.Context, ingestor RouteIngestor) error {
+func (c *openShiftFileCollector) StreamGroups(ctx context.Context, ingestor GroupIngestor) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
-	span.SetTag(tag.EntityTag, tag.EntityRoutes)
+	span.SetTag(tag.EntityTag, tag.EntityGroups)
 	defer span.Finish()
 
-	err := filepath.WalkDir(c.cfg.Directory, func(path string, d fs.DirEntry, err error) error {
-		if path == c.cfg.Directory || !d.IsDir() {
-			// Skip files
-			return nil
+	err := streamFileEntity(ctx, c.FileCollector, groupEntity, func(item *userv1.Group) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityGroups)), 1)
+		err := ingestor.IngestGroup(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing OpenShift group %s: %w", item.Name, err)
 		}
 
-		fp := filepath.Join(path, routePath)
-		c.log.Debugf("Streaming pods from file %s", fp)
-
-		return c.streamRoutesNamespace(ctx, fp, ingestor)
+		return nil
 	})
-
-	if err != nil {
-		return fmt.Errorf("file collector stream routes: %w", err)
+	if errors.Is(err, os.ErrNotExist) {
+		c.log.Debugf("No OpenShift groups file found, skipping: %v", err)
+	} else if err != nil {
+		return fmt.Errorf("file collector stream groups: %w", err)
 	}
 
 	return ingestor.Complete(ctx)
@@ -0,0 +1,54 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Secrets are stored in the per namespace file structure. The files are optional as they were not part of the file
+// structure of earlier versions.
+const (
+	secretPath = "secrets.json"
+)
+
+var (
+	secretEntity = fileEntity{path: secretPath, kind: "Secret", namespaced: true}
+)
+
+// StreamSecrets streams the secrets of all namespaces. The files may have been produced by a third party tool and hold
+// the secrets data, in which case the identity of the kubeconfig they hold (if any) is resolved from the data. The
+// secrets are then redacted before being passed to the ingestor. Secrets dumped by KubeHound are already redacted and
+// only hold the resolved kubeconfig identity in their annotations.
+func (c *FileCollector) StreamSecrets(ctx context.Context, ingestor SecretIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntitySecrets)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, secretEntity, func(item *corev1.Secret) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntitySecrets)), 1)
+		if len(item.Data) > 0 || len(item.StringData) > 0 {
+			libkube.ResolveKubeconfigSecret(item)
+		}
+
+		libkube.RedactSecret(item)
+		err := ingestor.IngestSecret(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s secret %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream secrets: %w", err)
+	}
+
+	return ingestor.Complete(ctx)
+}
@@ -0,0 +1,338 @@
+package collector
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	gzipExtension  = ".gz"
+	zipExtension   = ".zip"
+	tarExtension   = ".tar"
+	tarGzExtension = ".tar.gz"
+	tgzExtension   = ".tgz"
+)
+
+// fileMatchFunc is invoked by a fileSource for each file, with its slash separated path relative to the source root
+// (stripped of any .gz extension), to select the files to be walked. Files not matching are never opened.
+type fileMatchFunc func(relPath string) bool
+
+// fileWalkFunc is invoked by a fileSource for each matching file, with its slash separated path relative to the source
+// root. Compressed (.gz) files are transparently decompressed and the extension stripped from the provided path.
+// Returning fs.SkipAll stops the walk without error.
+type fileWalkFunc func(relPath string, r io.Reader) error
+
+// fileSource abstracts the storage of the K8s data files consumed by the file collector (directory or archive).
+type fileSource interface {
+	// Walk streams all the files of the source matching the provided function to the walk function.
+	Walk(ctx context.Context, match fileMatchFunc, fn fileWalkFunc) error
+
+	// Close releases the resources held by the source. The source cannot be reused after this call.
+	Close() error
+}
+
+// newFileSource creates the file source matching the provided path, based on its extension.
+func newFileSource(root string) fileSource {
+	switch {
+	case strings.HasSuffix(root, zipExtension):
+		return &zipFileSource{path: root}
+	case strings.HasSuffix(root, tarGzExtension), strings.HasSuffix(root, tgzExtension):
+		return &tarFileSource{path: root, gzipped: true}
+	case strings.HasSuffix(root, tarExtension):
+		return &tarFileSource{path: root}
+	default:
+		return &dirFileSource{root: root}
+	}
+}
+
+// isArchive returns whether the provided path has the extension of an archive supported by the file collector.
+func isArchive(root string) bool {
+	_, ok := newFileSource(root).(*dirFileSource)
+
+	return !ok
+}
+
+// walkEntry invokes the walk function on a single file entry if it matches, decompressing it if required. The entry
+// is only opened once matched.
+func walkEntry(ctx context.Context, match fileMatchFunc, fn fileWalkFunc, relPath string,
+	open func() (io.ReadCloser, error)) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name := strings.TrimSuffix(relPath, gzipExtension)
+	if !match(name) {
+		return nil
+	}
+
+	r, err := open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if name == relPath {
+		return fn(relPath, r)
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("opening compressed file %s: %w", relPath, err)
+	}
+	defer gz.Close()
+
+	return fn(name, gz)
+}
+
+// dirFileSource reads the K8s data files from a directory on disk.
+type dirFileSource struct {
+	root string
+}
+
+func (s *dirFileSource) Walk(ctx context.Context, match fileMatchFunc, fn fileWalkFunc) error {
+	return filepath.WalkDir(s.root, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, fp)
+		if err != nil {
+			return fmt.Errorf("relative path of %s: %w", fp, err)
+		}
+
+		return walkEntry(ctx, match, fn, filepath.ToSlash(rel), func() (io.ReadCloser, error) {
+			f, err := os.Open(fp)
+			if err != nil {
+				return nil, fmt.Errorf("open file %s: %w", fp, err)
+			}
+
+			return f, nil
+		})
+	})
+}
+
+func (s *dirFileSource) Close() error {
+	// NOP for this implementation
+	return nil
+}
+
+// zipFileSource reads the K8s data files from a zip archive.
+type zipFileSource struct {
+	path string
+}
+
+func (s *zipFileSource) Walk(ctx context.Context, match fileMatchFunc, fn fileWalkFunc) error {
+	zr, err := zip.OpenReader(s.path)
+	if err != nil {
+		return fmt.Errorf("open zip archive %s: %w", s.path, err)
+	}
+	defer zr.Close()
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+
+		err := walkEntry(ctx, match, fn, strings.TrimPrefix(zf.Name, "./"), func() (io.ReadCloser, error) {
+			r, err := zf.Open()
+			if err != nil {
+				return nil, fmt.Errorf("open zip archive file %s: %w", zf.Name, err)
+			}
+
+			return r, nil
+		})
+		if errors.Is(err, fs.SkipAll) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *zipFileSource) Close() error {
+	// NOP for this implementation
+	return nil
+}
+
+// tarEntry locates the data of a regular file within an uncompressed tar archive.
+type tarEntry struct {
+	name   string
+	offset int64
+	size   int64
+}
+
+// tarFileSource reads the K8s data files from a (optionally gzip compressed) tar archive.
+// Tar archives do not support random access, so the archive is kept open from the first walk (until Close) and:
+//   - uncompressed archives are indexed once, and the files subsequently read in place from the archive
+//   - compressed archives are decompressed sequentially on each walk, as a gzip stream cannot be seeked, skipping over
+//     the non-matching files
+type tarFileSource struct {
+	path    string
+	gzipped bool
+
+	once    sync.Once
+	err     error
+	file    *os.File   // Archive, kept open across walks
+	size    int64      // Size of the archive, read by each walk of a compressed archive
+	entries []tarEntry // Index of the regular files of an uncompressed archive
+}
+
+func (s *tarFileSource) Walk(ctx context.Context, match fileMatchFunc, fn fileWalkFunc) error {
+	s.once.Do(func() {
+		s.err = s.open()
+	})
+	if s.err != nil {
+		return s.err
+	}
+
+	if s.gzipped {
+		return s.stream(ctx, match, fn)
+	}
+
+	for _, e := range s.entries {
+		err := walkEntry(ctx, match, fn, e.name, func() (io.ReadCloser, error) {
+			return io.NopCloser(io.NewSectionReader(s.file, e.offset, e.size)), nil
+		})
+		if errors.Is(err, fs.SkipAll) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// open opens the archive, indexing it if uncompressed.
+func (s *tarFileSource) open() error {
+	if !s.gzipped {
+		return s.index()
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("open tar archive %s: %w", s.path, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+
+		return fmt.Errorf("open tar archive %s: %w", s.path, err)
+	}
+
+	s.file = f
+	s.size = info.Size()
+
+	return nil
+}
+
+// stream decompresses a compressed tar archive from the start, invoking the walk function on the matching regular
+// files as they are read. Non-matching files are skipped by the tar reader without being buffered.
+func (s *tarFileSource) stream(ctx context.Context, match fileMatchFunc, fn fileWalkFunc) error {
+	gz, err := gzip.NewReader(io.NewSectionReader(s.file, 0, s.size))
+	if err != nil {
+		return fmt.Errorf("open compressed tar archive %s: %w", s.path, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("read tar archive %s: %w", s.path, err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		err = walkEntry(ctx, match, fn, strings.TrimPrefix(hdr.Name, "./"), func() (io.ReadCloser, error) {
+			return io.NopCloser(tr), nil
+		})
+		if errors.Is(err, fs.SkipAll) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// index records the location of all the regular files of an uncompressed tar archive.
+func (s *tarFileSource) index() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("open tar archive %s: %w", s.path, err)
+	}
+
+	// The tar reader consumes exactly the header blocks of an entry, leaving the file offset at the start of its data
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			f.Close()
+
+			return fmt.Errorf("read tar archive %s: %w", s.path, err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		offset, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			f.Close()
+
+			return fmt.Errorf("read tar archive %s: %w", s.path, err)
+		}
+
+		s.entries = append(s.entries, tarEntry{
+			name:   strings.TrimPrefix(hdr.Name, "./"),
+			offset: offset,
+			size:   hdr.Size,
+		})
+	}
+
+	s.file = f
+
+	return nil
+}
+
+func (s *tarFileSource) Close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	return s.file.Close()
+}
@@ -0,0 +1,311 @@
+package collector
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testClusterDir = "testdata/test-cluster"
+)
+
+// walkTestCluster invokes the provided function on every file of the test cluster data.
+func walkTestCluster(t *testing.T, fn func(relPath string, data []byte)) {
+	t.Helper()
+
+	err := filepath.WalkDir(testClusterDir, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(testClusterDir, fp)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(fp)
+		if err != nil {
+			return err
+		}
+
+		fn(filepath.ToSlash(rel), data)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func writeTestTar(t *testing.T, w io.Writer, prefix string) {
+	t.Helper()
+
+	tw := tar.NewWriter(w)
+	walkTestCluster(t, func(relPath string, data []byte) {
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     prefix + relPath,
+			Mode:     0o600,
+			Size:     int64(len(data)),
+		})
+		assert.NoError(t, err)
+
+		_, err = tw.Write(data)
+		assert.NoError(t, err)
+	})
+	assert.NoError(t, tw.Close())
+}
+
+func newTestTar(t *testing.T) string {
+	t.Helper()
+
+	fp := filepath.Join(t.TempDir(), "test-cluster.tar")
+	f, err := os.Create(fp)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	writeTestTar(t, f, "")
+
+	return fp
+}
+
+func newTestTarGz(t *testing.T) string {
+	t.Helper()
+
+	fp := filepath.Join(t.TempDir(), "test-cluster.tar.gz")
+	f, err := os.Create(fp)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	writeTestTar(t, gz, "./test-cluster/")
+	assert.NoError(t, gz.Close())
+
+	return fp
+}
+
+func newTestZip(t *testing.T) string {
+	t.Helper()
+
+	fp := filepath.Join(t.TempDir(), "test-cluster.zip")
+	f, err := os.Create(fp)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	walkTestCluster(t, func(relPath string, data []byte) {
+		w, err := zw.Create(relPath)
+		assert.NoError(t, err)
+
+		_, err = w.Write(data)
+		assert.NoError(t, err)
+	})
+	assert.NoError(t, zw.Close())
+
+	return fp
+}
+
+func newTestGzDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	walkTestCluster(t, func(relPath string, data []byte) {
+		fp := filepath.Join(dir, filepath.FromSlash(relPath)) + ".gz"
+		assert.NoError(t, os.MkdirAll(filepath.Dir(fp), 0o700))
+
+		f, err := os.Create(fp)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		gz := gzip.NewWriter(f)
+		_, err = gz.Write(data)
+		assert.NoError(t, err)
+		assert.NoError(t, gz.Close())
+	})
+
+	return dir
+}
+
+func TestFileCollector_Archives(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input func(t *testing.T) string
+	}{
+		{
+			name:  "tar archive",
+			input: newTestTar,
+		},
+		{
+			name:  "tar.gz archive with top level directory",
+			input: newTestTarGz,
+		},
+		{
+			name:  "zip archive",
+			input: newTestZip,
+		},
+		{
+			name:  "directory of gzip compressed files",
+			input: newTestGzDir,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := config.NewConfig("testdata/kubehound-test.yaml")
+			assert.NoError(t, err)
+			cfg.Collector.File.Directory = tt.input(t)
+
+			c, err := NewFileCollector(context.Background(), cfg)
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			ok, err := c.HealthCheck(ctx)
+			assert.True(t, ok)
+			assert.NoError(t, err)
+
+			pods := mocks.NewPodIngestor(t)
+			pods.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Twice()
+			pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+			assert.NoError(t, c.StreamPods(ctx, pods))
+
+			nodes := mocks.NewNodeIngestor(t)
+			nodes.EXPECT().IngestNode(mock.Anything, mock.AnythingOfType("types.NodeType")).Return(nil).Once()
+			nodes.EXPECT().Complete(mock.Anything).Return(nil).Once()
+			assert.NoError(t, c.StreamNodes(ctx, nodes))
+
+			crbs := mocks.NewClusterRoleBindingIngestor(t)
+			crbs.EXPECT().IngestClusterRoleBinding(mock.Anything, mock.AnythingOfType("types.ClusterRoleBindingType")).Return(nil).Once()
+			crbs.EXPECT().Complete(mock.Anything).Return(nil).Once()
+			assert.NoError(t, c.StreamClusterRoleBindings(ctx, crbs))
+		})
+	}
+}
+
+func TestFileCollector_MissingClusterFile(t *testing.T) {
+	t.Parallel()
+
+	c := &FileCollector{
+		cfg: &config.FileCollectorConfig{
+			Directory:   filepath.Join(testClusterDir, "namespace-1"),
+			ClusterName: "test-cluster",
+		},
+		log: log.Trace(context.Background(), log.WithComponent(globals.FileCollectorComponent)),
+	}
+
+	nodes := mocks.NewNodeIngestor(t)
+	err := c.StreamNodes(context.Background(), nodes)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFileCollector_ArchiveReadOnce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input func(t *testing.T) string
+	}{
+		{
+			name:  "tar archive",
+			input: newTestTar,
+		},
+		{
+			name:  "tar.gz archive",
+			input: newTestTarGz,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := config.NewConfig("testdata/kubehound-test.yaml")
+			assert.NoError(t, err)
+			cfg.Collector.File.Directory = tt.input(t)
+
+			c, err := NewFileCollector(context.Background(), cfg)
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			nodes := mocks.NewNodeIngestor(t)
+			nodes.EXPECT().IngestNode(mock.Anything, mock.AnythingOfType("types.NodeType")).Return(nil).Once()
+			nodes.EXPECT().Complete(mock.Anything).Return(nil).Once()
+			assert.NoError(t, c.StreamNodes(ctx, nodes))
+
+			// The archive is kept open, so the subsequent entity streams must not reopen it
+			assert.NoError(t, os.Remove(cfg.Collector.File.Directory))
+
+			pods := mocks.NewPodIngestor(t)
+			pods.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Twice()
+			pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+			assert.NoError(t, c.StreamPods(ctx, pods))
+
+			assert.NoError(t, c.Close(ctx))
+		})
+	}
+}
+
+func TestFileSource_SkipUnmatched(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "pods.json"), []byte("{}"), 0o600))
+	// Not a valid gzip stream, must never be opened as it is not matched
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "nodes.json.gz"), []byte("not gzip"), 0o600))
+
+	var walked []string
+	err := newFileSource(dir).Walk(context.Background(), func(relPath string) bool {
+		return relPath == "pods.json"
+	}, func(relPath string, _ io.Reader) error {
+		walked = append(walked, relPath)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pods.json"}, walked)
+}
+
+//nolint:paralleltest // t.Setenv is not compatible with parallel tests
+func TestFileSource_TarGzStreamed(t *testing.T) {
+	archive := newTestTarGz(t)
+
+	// Any file spilled by the source would land in the temporary directory
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+
+	src := newFileSource(archive)
+	for i := 0; i < 2; i++ {
+		var walked []string
+		err := src.Walk(context.Background(), func(relPath string) bool {
+			return strings.HasSuffix(relPath, podPath)
+		}, func(relPath string, r io.Reader) error {
+			walked = append(walked, relPath)
+			_, err := io.Copy(io.Discard, r)
+
+			return err
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, walked)
+	}
+	assert.NoError(t, src.Close())
+
+	entries, err := os.ReadDir(tmp)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -3,12 +3,18 @@ package collector
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
 	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestFileCollector_Constructor(t *testing.T) {
@@ -95,6 +101,40 @@ func TestFileCollector_StreamNodes(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFileCollector_StreamNamespaces(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewNamespaceIngestor(t)
+
+	i.EXPECT().IngestNamespace(mock.Anything, mock.AnythingOfType("types.NamespaceType")).Return(nil).Twice()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamNamespaces(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamNamespacesMissing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &FileCollector{
+		cfg: &config.FileCollectorConfig{
+			Directory:   t.TempDir(),
+			ClusterName: "test-cluster",
+		},
+		log: log.Trace(ctx, log.WithComponent(globals.FileCollectorComponent)),
+	}
+
+	// Dumps predating the namespace collection do not hold a namespaces file
+	i := mocks.NewNamespaceIngestor(t)
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamNamespaces(ctx, i)
+	assert.NoError(t, err)
+}
+
 func TestFileCollector_StreamPods(t *testing.T) {
 	t.Parallel()
 
@@ -178,3 +218,237 @@ func TestFileCollector_StreamEndpoints(t *testing.T) {
 	err := c.StreamEndpoints(ctx, i)
 	assert.NoError(t, err)
 }
+
+func TestFileCollector_StreamServices(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewServiceIngestor(t)
+
+	// Service files are optional and only provided for some namespaces
+	i.EXPECT().IngestService(mock.Anything, mock.AnythingOfType("types.ServiceType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamServices(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamWorkloads(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewWorkloadIngestor(t)
+
+	// Workload files are optional and only provided for some namespaces
+	i.EXPECT().IngestDaemonSet(mock.Anything, mock.AnythingOfType("types.DaemonSetType")).Return(nil).Once()
+	i.EXPECT().IngestDeployment(mock.Anything, mock.AnythingOfType("types.DeploymentType")).Return(nil).Once()
+	i.EXPECT().IngestReplicaSet(mock.Anything, mock.AnythingOfType("types.ReplicaSetType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamWorkloads(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamIngresses(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewIngressIngestor(t)
+
+	// Ingress and Gateway API files are optional and only provided for some namespaces
+	i.EXPECT().IngestIngress(mock.Anything, mock.AnythingOfType("types.IngressType")).Return(nil).Once()
+	i.EXPECT().IngestGateway(mock.Anything, mock.AnythingOfType("types.GatewayType")).Return(nil).Once()
+	i.EXPECT().IngestHTTPRoute(mock.Anything, mock.AnythingOfType("types.HTTPRouteType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamIngresses(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamNetworkPolicies(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewNetworkPolicyIngestor(t)
+
+	// Network policy files are optional and only provided for some namespaces
+	i.EXPECT().IngestNetworkPolicy(mock.Anything, mock.AnythingOfType("types.NetworkPolicyType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamNetworkPolicies(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamWebhookConfigurations(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewWebhookConfigurationIngestor(t)
+
+	// Webhook configuration files are optional, only the mutating one is provided
+	i.EXPECT().IngestMutatingWebhookConfiguration(mock.Anything, mock.AnythingOfType("types.MutatingWebhookConfigurationType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamWebhookConfigurations(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_StreamCustomResources(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	c.customResources = []config.CustomResourceConfig{testApplicationResource, testIssuerResource}
+	ctx := context.Background()
+	i := mocks.NewCustomResourceIngestor(t)
+
+	// Namespaced objects are read from the namespace directories and cluster scoped objects from the root directory
+	i.EXPECT().IngestCustomResource(mock.Anything, customResourceGVR(testApplicationResource), mock.AnythingOfType("types.CustomResourceType")).Return(nil).Once()
+	i.EXPECT().IngestCustomResource(mock.Anything, customResourceGVR(testIssuerResource), mock.AnythingOfType("types.CustomResourceType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamCustomResources(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_APIResources(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+
+	lists, err := c.APIResources(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, lists, 2)
+	assert.Equal(t, "v1", lists[0].GroupVersion)
+	assert.Equal(t, "pods/exec", lists[0].APIResources[1].Name)
+	assert.Equal(t, "argoproj.io/v1alpha1", lists[1].GroupVersion)
+
+	// The discovery file is optional
+	c = &FileCollector{
+		cfg: &config.FileCollectorConfig{
+			Directory:   "testdata/test-cluster/namespace-1/",
+			ClusterName: "test-cluster",
+		},
+		log: c.log,
+	}
+	lists, err = c.APIResources(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, lists)
+}
+
+func TestFileCollector_StreamSecrets(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestFileCollector(t)
+	ctx := context.Background()
+	i := mocks.NewSecretIngestor(t)
+
+	// Secret files are optional and the data they hold must never reach the ingestor
+	i.EXPECT().IngestSecret(mock.Anything, mock.AnythingOfType("types.SecretType")).
+		RunAndReturn(func(_ context.Context, secret types.SecretType) error {
+			assert.Nil(t, secret.Data)
+			assert.NotContains(t, secret.Annotations, corev1.LastAppliedConfigAnnotation)
+			switch secret.Name {
+			case "test-app-token":
+				assert.Equal(t, corev1.SecretTypeServiceAccountToken, secret.Type)
+				assert.Equal(t, "test-app", secret.Annotations[corev1.ServiceAccountNameKey])
+			case "test-app-kubeconfig":
+				// The kubeconfig identity is resolved from the data before it is redacted
+				assert.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+				assert.Equal(t, "system:serviceaccount:test-app:deployer", secret.Annotations[libkube.KubeconfigUserAnnotation])
+			default:
+				assert.Fail(t, "unexpected secret", secret.Name)
+			}
+
+			return nil
+		}).Twice()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err := c.StreamSecrets(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestFileCollector_NamespaceFilter(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.NewConfig("testdata/kubehound-test.yaml")
+	assert.NoError(t, err)
+	cfg.Collector.Namespaces = &config.NamespaceFilterConfig{
+		Exclude: []string{"namespace-2"},
+	}
+
+	c, err := NewFileCollector(context.Background(), cfg)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	i := mocks.NewPodIngestor(t)
+
+	i.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Once()
+	i.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	err = c.StreamPods(ctx, i)
+	assert.NoError(t, err)
+}
+
+func TestStreamList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "empty file",
+			input: "",
+			want:  []string{},
+		},
+		{
+			name:  "null items",
+			input: `{"apiVersion":"v1","items":null,"kind":"List"}`,
+			want:  []string{},
+		},
+		{
+			name:  "fields around items",
+			input: `{"apiVersion":"v1","items":[{"metadata":{"name":"a"}},{"metadata":{"name":"b"}}],"kind":"List","metadata":{"resourceVersion":""}}`,
+			want:  []string{"a", "b"},
+		},
+		{
+			name:    "not an object",
+			input:   `[{"metadata":{"name":"a"}}]`,
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name:    "truncated",
+			input:   `{"items":[{"metadata":{"name":"a"}},{"meta`,
+			want:    []string{"a"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := []string{}
+			err := streamList(context.Background(), "test.json", strings.NewReader(tt.input), func(item *corev1.Pod) error {
+				got = append(got, item.Name)
+
+				return nil
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+// Admission webhook configurations are cluster scoped and stored at the root of the file structure. The files are
+// optional as they were not part of the file structure of earlier versions.
+const (
+	mutatingWebhookPath   = "mutatingwebhookconfigurations.admissionregistration.k8s.io.json"
+	validatingWebhookPath = "validatingwebhookconfigurations.admissionregistration.k8s.io.json"
+)
+
+var (
+	mutatingWebhookEntity   = fileEntity{path: mutatingWebhookPath, group: admissionregistrationv1.GroupName, kind: "MutatingWebhookConfiguration"}
+	validatingWebhookEntity = fileEntity{path: validatingWebhookPath, group: admissionregistrationv1.GroupName, kind: "ValidatingWebhookConfiguration"}
+)
+
+// StreamWebhookConfigurations streams the mutating and validating admission webhook configurations of the cluster.
+func (c *FileCollector) StreamWebhookConfigurations(ctx context.Context, ingestor WebhookConfigurationIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityMutatingWebhooks)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, mutatingWebhookEntity, func(item *admissionregistrationv1.MutatingWebhookConfiguration) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityMutatingWebhooks)), 1)
+		err := ingestor.IngestMutatingWebhookConfiguration(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s mutating webhook configuration %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		c.log.Debugf("No mutating webhook configurations file found, skipping: %v", err)
+	} else if err != nil {
+		return fmt.Errorf("file collector stream mutating webhook configurations: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, validatingWebhookEntity, func(item *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityValidatingWebhooks)), 1)
+		err := ingestor.IngestValidatingWebhookConfiguration(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s validating webhook configuration %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		c.log.Debugf("No validating webhook configurations file found, skipping: %v", err)
+	} else if err != nil {
+		return fmt.Errorf("file collector stream validating webhook configurations: %w", err)
+	}
+
+	return ingestor.Complete(ctx)
+}
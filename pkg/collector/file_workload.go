@@ -0,0 +1,122 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+// Workload controllers are stored in the per namespace file structure alongside the pods. The files are optional as
+// they were not part of the file structure of earlier versions.
+const (
+	deploymentPath  = "deployments.apps.json"
+	daemonSetPath   = "daemonsets.apps.json"
+	statefulSetPath = "statefulsets.apps.json"
+	replicaSetPath  = "replicasets.apps.json"
+	jobPath         = "jobs.batch.json"
+	cronJobPath     = "cronjobs.batch.json"
+)
+
+var (
+	deploymentEntity  = fileEntity{path: deploymentPath, group: appsv1.GroupName, kind: "Deployment", namespaced: true}
+	daemonSetEntity   = fileEntity{path: daemonSetPath, group: appsv1.GroupName, kind: "DaemonSet", namespaced: true}
+	statefulSetEntity = fileEntity{path: statefulSetPath, group: appsv1.GroupName, kind: "StatefulSet", namespaced: true}
+	replicaSetEntity  = fileEntity{path: replicaSetPath, group: appsv1.GroupName, kind: "ReplicaSet", namespaced: true}
+	jobEntity         = fileEntity{path: jobPath, group: batchv1.GroupName, kind: "Job", namespaced: true}
+	cronJobEntity     = fileEntity{path: cronJobPath, group: batchv1.GroupName, kind: "CronJob", namespaced: true}
+)
+
+// StreamWorkloads streams the workload controllers of all namespaces. Owning controllers are streamed before the
+// controllers they manage (deployments before replica sets, cron jobs before jobs).
+func (c *FileCollector) StreamWorkloads(ctx context.Context, ingestor WorkloadIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityWorkloads)
+	defer span.Finish()
+
+	err := streamFileEntity(ctx, c, deploymentEntity, func(item *appsv1.Deployment) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityDeployments)), 1)
+		err := ingestor.IngestDeployment(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s deployment %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream deployments: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, daemonSetEntity, func(item *appsv1.DaemonSet) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityDaemonSets)), 1)
+		err := ingestor.IngestDaemonSet(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s daemon set %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream daemon sets: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, statefulSetEntity, func(item *appsv1.StatefulSet) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityStatefulSets)), 1)
+		err := ingestor.IngestStatefulSet(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s stateful set %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream stateful sets: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, replicaSetEntity, func(item *appsv1.ReplicaSet) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityReplicaSets)), 1)
+		err := ingestor.IngestReplicaSet(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s replica set %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream replica sets: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, cronJobEntity, func(item *batchv1.CronJob) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityCronJobs)), 1)
+		err := ingestor.IngestCronJob(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s cron job %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream cron jobs: %w", err)
+	}
+
+	err = streamFileEntity(ctx, c, jobEntity, func(item *batchv1.Job) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityJobs)), 1)
+		err := ingestor.IngestJob(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s job %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("file collector stream jobs: %w", err)
+	}
+
+	return ingestor.Complete(ctx)
+}
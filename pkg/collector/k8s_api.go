@@ -19,19 +19,26 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/tools/pager"
-	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 // FileCollector implements a collector based on local K8s API json files generated outside the KubeHound application via e.g kubectl.
 type k8sAPICollector struct {
-	clientset kubernetes.Interface
-	log       *log.KubehoundLogger
-	rl        ratelimit.Limiter
-	cfg       *config.K8SAPICollectorConfig
-	tags      []string
+	clientset       kubernetes.Interface
+	dynamic         dynamic.Interface  // Client for the resources without a typed clientset (e.g Gateway API)
+	metadata        metadata.Interface // Client for the resources collected without their content (e.g secrets)
+	log             *log.KubehoundLogger
+	rl              ratelimit.Limiter
+	cfg             *config.K8SAPICollectorConfig
+	tags            []string
+	nsFilter        *namespaceFilter
+	clusterName     string
+	resources       []resourceAccess              // Resources listed by the collector, checked by the permission preflight
+	customResources []config.CustomResourceConfig // Custom resources collected through the dynamic client
+	denied          map[string]struct{}           // Entity types skipped due to missing permissions
 }
 
 const (
@@ -70,24 +77,55 @@ func NewK8sAPICollector(ctx context.Context, cfg *config.KubehoundConfig) (Colle
 		return nil, err
 	}
 
-	kubeConfig, err := ctrl.GetConfig()
+	err = checkPreflightMode(cfg.Collector.Live.Preflight)
 	if err != nil {
-		return nil, fmt.Errorf("building kubernetes config: %w", err)
+		return nil, err
+	}
+
+	err = checkCustomResourceConfig(cfg.Collector.CustomResources)
+	if err != nil {
+		return nil, err
 	}
 
-	kubeConfig.UserAgent = CollectorUserAgent
+	kubeConfig, err := loadKubeClientConfig(cfg.Collector.Live)
+	if err != nil {
+		return nil, err
+	}
 
-	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	clientset, err := kubernetes.NewForConfig(kubeConfig.rest)
 	if err != nil {
 		return nil, fmt.Errorf("getting kubernetes config: %w", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig.rest)
+	if err != nil {
+		return nil, fmt.Errorf("getting kubernetes dynamic client: %w", err)
+	}
+
+	metadataClient, err := metadata.NewForConfig(kubeConfig.rest)
+	if err != nil {
+		return nil, fmt.Errorf("getting kubernetes metadata client: %w", err)
+	}
+
+	nsFilter, err := newNamespaceFilter(cfg.Collector.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Creating k8s API collector for cluster %s", kubeConfig.clusterName)
+
 	return &k8sAPICollector{
-		cfg:       cfg.Collector.Live,
-		clientset: clientset,
-		log:       l,
-		rl:        ratelimit.New(cfg.Collector.Live.RateLimitPerSecond), // per second
-		tags:      tags,
+		cfg:             cfg.Collector.Live,
+		clientset:       clientset,
+		dynamic:         dynamicClient,
+		metadata:        metadataClient,
+		log:             l,
+		rl:              ratelimit.New(cfg.Collector.Live.RateLimitPerSecond), // per second
+		tags:            tags,
+		nsFilter:        nsFilter,
+		clusterName:     kubeConfig.clusterName,
+		resources:       append(append([]resourceAccess{}, k8sResourceAccess...), customResourceAccess(cfg.Collector.CustomResources)...),
+		customResources: cfg.Collector.CustomResources,
 	}, nil
 }
 
@@ -108,21 +146,16 @@ func (c *k8sAPICollector) HealthCheck(ctx context.Context) (bool, error) {
 		return false, fmt.Errorf("/healthz request not ok: response: %s", res)
 	}
 
+	if err := c.preflight(ctx); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
 func (c *k8sAPICollector) ClusterInfo(ctx context.Context) (*ClusterInfo, error) {
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	configOverrides := &clientcmd.ConfigOverrides{}
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
-
-	raw, err := kubeConfig.RawConfig()
-	if err != nil {
-		return nil, fmt.Errorf("raw config get: %w", err)
-	}
-
 	return &ClusterInfo{
-		Name: raw.CurrentContext,
+		Name: c.clusterName,
 	}, nil
 }
 
@@ -177,6 +210,10 @@ func (c *k8sAPICollector) streamPodsNamespace(ctx context.Context, namespace str
 			return fmt.Errorf("pod stream type conversion error: %T", obj)
 		}
 
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
 		err := ingestor.IngestPod(ctx, item)
 		if err != nil {
 			return fmt.Errorf("processing K8s pod %s for namespace %s: %w", item.Name, namespace, err)
@@ -191,6 +228,10 @@ func (c *k8sAPICollector) StreamPods(ctx context.Context, ingestor PodIngestor)
 	span.SetTag(tag.EntityTag, tag.EntityPods)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityPods) {
+		return ingestor.Complete(ctx)
+	}
+
 	// passing an empty namespace will collect all namespaces
 	err := c.streamPodsNamespace(ctx, "", ingestor)
 	if err != nil {
@@ -227,6 +268,10 @@ func (c *k8sAPICollector) streamRolesNamespace(ctx context.Context, namespace st
 			return fmt.Errorf("role stream type conversion error: %T", obj)
 		}
 
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
 		err := ingestor.IngestRole(ctx, item)
 		if err != nil {
 			return fmt.Errorf("processing K8s roles %s for namespace %s: %w", item.Name, namespace, err)
@@ -241,6 +286,10 @@ func (c *k8sAPICollector) StreamRoles(ctx context.Context, ingestor RoleIngestor
 	span.SetTag(tag.EntityTag, tag.EntityRoles)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityRoles) {
+		return ingestor.Complete(ctx)
+	}
+
 	// passing an empty namespace will collect all namespaces
 	err := c.streamRolesNamespace(ctx, "", ingestor)
 	if err != nil {
@@ -277,6 +326,10 @@ func (c *k8sAPICollector) streamRoleBindingsNamespace(ctx context.Context, names
 			return fmt.Errorf("role binding stream type conversion error: %T", obj)
 		}
 
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
 		err := ingestor.IngestRoleBinding(ctx, item)
 		if err != nil {
 			return fmt.Errorf("processing K8s rolebinding %s for namespace %s: %w", item.Name, namespace, err)
@@ -291,6 +344,10 @@ func (c *k8sAPICollector) StreamRoleBindings(ctx context.Context, ingestor RoleB
 	span.SetTag(tag.EntityTag, tag.EntityRolebindings)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityRolebindings) {
+		return ingestor.Complete(ctx)
+	}
+
 	// passing an empty namespace will collect all namespaces
 	err := c.streamRoleBindingsNamespace(ctx, "", ingestor)
 	if err != nil {
@@ -327,6 +384,10 @@ func (c *k8sAPICollector) streamEndpointsNamespace(ctx context.Context, namespac
 			return fmt.Errorf("endpoint stream type conversion error: %T", obj)
 		}
 
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
 		err := ingestor.IngestEndpoint(ctx, item)
 		if err != nil {
 			return fmt.Errorf("processing K8s endpoint slice %s for namespace %s: %w", item.Name, namespace, err)
@@ -341,6 +402,10 @@ func (c *k8sAPICollector) StreamEndpoints(ctx context.Context, ingestor Endpoint
 	span.SetTag(tag.EntityTag, tag.EntityEndpoints)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityEndpoints) {
+		return ingestor.Complete(ctx)
+	}
+
 	// passing an empty namespace will collect all namespaces
 	err := c.streamEndpointsNamespace(ctx, "", ingestor)
 	if err != nil {
@@ -350,11 +415,73 @@ func (c *k8sAPICollector) StreamEndpoints(ctx context.Context, ingestor Endpoint
 	return ingestor.Complete(ctx)
 }
 
+// streamServicesNamespace streams the service objects corresponding to a cluster namespace.
+func (c *k8sAPICollector) streamServicesNamespace(ctx context.Context, namespace string, ingestor ServiceIngestor) error {
+	err := c.checkNamespaceExists(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	opts := tunedListOptions()
+	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		entries, err := c.clientset.CoreV1().Services(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting K8s services for namespace %s: %w", namespace, err)
+		}
+
+		return entries, err
+	}))
+
+	c.setPagerConfig(pager)
+
+	return pager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityServices)), 1)
+		c.rl.Take()
+		item, ok := obj.(*corev1.Service)
+		if !ok {
+			return fmt.Errorf("service stream type conversion error: %T", obj)
+		}
+
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
+		err := ingestor.IngestService(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s service %s for namespace %s: %w", item.Name, namespace, err)
+		}
+
+		return nil
+	})
+}
+
+func (c *k8sAPICollector) StreamServices(ctx context.Context, ingestor ServiceIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityServices)
+	defer span.Finish()
+
+	if c.accessDenied(tag.EntityServices) {
+		return ingestor.Complete(ctx)
+	}
+
+	// passing an empty namespace will collect all namespaces
+	err := c.streamServicesNamespace(ctx, "", ingestor)
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
+
 func (c *k8sAPICollector) StreamNodes(ctx context.Context, ingestor NodeIngestor) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
 	span.SetTag(tag.EntityTag, tag.EntityNodes)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityNodes) {
+		return ingestor.Complete(ctx)
+	}
+
 	opts := tunedListOptions()
 	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
 		entries, err := c.clientset.CoreV1().Nodes().List(ctx, opts)
@@ -394,6 +521,10 @@ func (c *k8sAPICollector) StreamClusterRoles(ctx context.Context, ingestor Clust
 	span.SetTag(tag.EntityTag, tag.EntityClusterRoles)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityClusterRoles) {
+		return ingestor.Complete(ctx)
+	}
+
 	opts := tunedListOptions()
 	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
 		entries, err := c.clientset.RbacV1().ClusterRoles().List(ctx, opts)
@@ -433,6 +564,10 @@ func (c *k8sAPICollector) StreamClusterRoleBindings(ctx context.Context, ingesto
 	span.SetTag(tag.EntityTag, tag.EntityClusterRolebindings)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityClusterRolebindings) {
+		return ingestor.Complete(ctx)
+	}
+
 	opts := tunedListOptions()
 	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
 		entries, err := c.clientset.RbacV1().ClusterRoleBindings().List(ctx, opts)
@@ -466,3 +601,95 @@ func (c *k8sAPICollector) StreamClusterRoleBindings(ctx context.Context, ingesto
 
 	return ingestor.Complete(ctx)
 }
+
+func (c *k8sAPICollector) StreamNamespaces(ctx context.Context, ingestor NamespaceIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityNamespaces)
+	defer span.Finish()
+
+	if c.accessDenied(tag.EntityNamespaces) {
+		return ingestor.Complete(ctx)
+	}
+
+	opts := tunedListOptions()
+	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		entries, err := c.clientset.CoreV1().Namespaces().List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting K8s namespaces: %w", err)
+		}
+
+		return entries, err
+	}))
+
+	c.setPagerConfig(pager)
+
+	err := pager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(tag.EntityNamespaces)), 1)
+		c.rl.Take()
+		item, ok := obj.(*corev1.Namespace)
+		if !ok {
+			return fmt.Errorf("namespace stream type conversion error: %T", obj)
+		}
+
+		if !c.nsFilter.Allowed(item.Name) {
+			return nil
+		}
+
+		err := ingestor.IngestNamespace(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s namespace %s: %w", item.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
+
+// kindListFunc lists a page of K8s objects of a single kind.
+type kindListFunc func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error)
+
+// streamKind streams all the namespaced objects of a single kind across all namespaces. The kind is skipped if the
+// permission preflight reported a missing list permission on the corresponding resource.
+func streamKind[T metav1.Object](ctx context.Context, c *k8sAPICollector, entity string,
+	list kindListFunc, ingest func(context.Context, T) error) error {
+
+	if c.accessDenied(entity) {
+		return nil
+	}
+
+	opts := tunedListOptions()
+	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		entries, err := list(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting K8s %s: %w", entity, err)
+		}
+
+		return entries, err
+	}))
+
+	c.setPagerConfig(pager)
+
+	return pager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(entity)), 1)
+		c.rl.Take()
+		item, ok := obj.(T)
+		if !ok {
+			return fmt.Errorf("%s stream type conversion error: %T", entity, obj)
+		}
+
+		if !c.nsFilter.Allowed(item.GetNamespace()) {
+			return nil
+		}
+
+		err := ingest(ctx, item)
+		if err != nil {
+			return fmt.Errorf("processing K8s %s %s for namespace %s: %w", entity, item.GetName(), item.GetNamespace(), err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// checkCustomResourceConfig validates the configured custom resources.
+func checkCustomResourceConfig(crs []config.CustomResourceConfig) error {
+	for _, cr := range crs {
+		if cr.Resource == "" || cr.Version == "" {
+			return fmt.Errorf("invalid custom resource config %q: resource and version must be provided", cr)
+		}
+	}
+
+	return nil
+}
+
+// customResourceGVR returns the group version resource of a configured custom resource.
+func customResourceGVR(cr config.CustomResourceConfig) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    cr.Group,
+		Version:  cr.Version,
+		Resource: cr.Resource,
+	}
+}
+
+// customResourceAccess returns the resources listed by the live collectors to stream the configured custom resources.
+func customResourceAccess(crs []config.CustomResourceConfig) []resourceAccess {
+	access := make([]resourceAccess, 0, len(crs))
+	for _, cr := range crs {
+		access = append(access, resourceAccess{entity: cr.String(), group: cr.Group, resource: cr.Resource})
+	}
+
+	return access
+}
+
+// StreamCustomResources streams the objects of all the configured custom resources, across all namespaces.
+func (c *k8sAPICollector) StreamCustomResources(ctx context.Context, ingestor CustomResourceIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityCustomResources)
+	defer span.Finish()
+
+	for _, cr := range c.customResources {
+		gvr := customResourceGVR(cr)
+		err := streamDynamic(ctx, c, cr.String(), gvr, func(ctx context.Context, item *unstructured.Unstructured) error {
+			return ingestor.IngestCustomResource(ctx, gvr, item)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ingestor.Complete(ctx)
+}
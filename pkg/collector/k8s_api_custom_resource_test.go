@@ -0,0 +1,93 @@
+package collector
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var (
+	testApplicationResource = config.CustomResourceConfig{
+		Group:    "argoproj.io",
+		Version:  "v1alpha1",
+		Resource: "applications",
+		Kind:     "Application",
+	}
+	testIssuerResource = config.CustomResourceConfig{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "clusterissuers",
+		Kind:     "ClusterIssuer",
+	}
+)
+
+func fakeCustomResourceObject(cr config.CustomResourceConfig, name string, namespace string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": cr.Group + "/" + cr.Version,
+			"kind":       cr.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+		},
+	}
+}
+
+func Test_k8sAPICollector_StreamCustomResources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c, ok := NewTestK8sAPICollector(ctx, fake.NewSimpleClientset()).(*k8sAPICollector)
+	assert.True(t, ok)
+	c.customResources = []config.CustomResourceConfig{testApplicationResource, testIssuerResource}
+
+	appGVR := customResourceGVR(testApplicationResource)
+	issuerGVR := customResourceGVR(testIssuerResource)
+	dc := newTestDynamicClient()
+	err := dc.Tracker().Create(appGVR, fakeCustomResourceObject(testApplicationResource, "app1", "argocd"), "argocd")
+	assert.NoError(t, err)
+	err = dc.Tracker().Create(issuerGVR, fakeCustomResourceObject(testIssuerResource, "issuer1", ""), "")
+	assert.NoError(t, err)
+	c.dynamic = dc
+
+	m := mocks.NewCustomResourceIngestor(t)
+	m.EXPECT().IngestCustomResource(mock.Anything, appGVR, mock.AnythingOfType("types.CustomResourceType")).
+		RunAndReturn(func(_ context.Context, _ schema.GroupVersionResource, cr types.CustomResourceType) error {
+			u := (*unstructured.Unstructured)(cr)
+			assert.Equal(t, "app1", u.GetName())
+			assert.Equal(t, "argocd", u.GetNamespace())
+
+			return nil
+		}).Once()
+	m.EXPECT().IngestCustomResource(mock.Anything, issuerGVR, mock.AnythingOfType("types.CustomResourceType")).
+		Return(nil).Once()
+	m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, c.StreamCustomResources(ctx, m))
+}
+
+func Test_checkCustomResourceConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, checkCustomResourceConfig(nil))
+	assert.NoError(t, checkCustomResourceConfig([]config.CustomResourceConfig{testApplicationResource}))
+	assert.Error(t, checkCustomResourceConfig([]config.CustomResourceConfig{{Group: "argoproj.io", Resource: "applications"}}))
+}
+
+func Test_customResourceAccess(t *testing.T) {
+	t.Parallel()
+
+	access := customResourceAccess([]config.CustomResourceConfig{testApplicationResource})
+	assert.Equal(t, []resourceAccess{
+		{entity: "applications.argoproj.io", group: "argoproj.io", resource: "applications"},
+	}, access)
+}
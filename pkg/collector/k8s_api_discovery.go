@@ -0,0 +1,26 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+)
+
+// APIResources returns the resources served by the cluster, as reported by the API discovery. Groups failing discovery
+// (e.g an unavailable aggregated API) are skipped with a warning.
+func (c *k8sAPICollector) APIResources(_ context.Context) ([]*metav1.APIResourceList, error) {
+	_, lists, err := c.clientset.Discovery().ServerGroupsAndResources()
+	if discovery.IsGroupDiscoveryFailedError(err) {
+		c.log.Warnf("Partial API discovery, RBAC wildcards will not be resolved for the failing groups: %v", err)
+
+		return lists, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("k8s api collector discovery: %w", err)
+	}
+
+	return lists, nil
+}
@@ -0,0 +1,125 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
+	"github.com/DataDog/KubeHound/pkg/telemetry/metric"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/statsd"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/pager"
+)
+
+// streamDynamic streams all the objects of a resource across all namespaces via the dynamic client. The resource is
+// skipped if the permission preflight reported a missing list permission on the corresponding entity or if the resource
+// is not served by the cluster.
+func streamDynamic(ctx context.Context, c *k8sAPICollector, entity string, gvr schema.GroupVersionResource,
+	ingest func(context.Context, *unstructured.Unstructured) error) error {
+
+	if c.accessDenied(entity) {
+		return nil
+	}
+
+	opts := tunedListOptions()
+	pager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		entries, err := c.dynamic.Resource(gvr).Namespace("").List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting K8s %s: %w", entity, err)
+		}
+
+		return entries, err
+	}))
+
+	c.setPagerConfig(pager)
+
+	err := pager.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		_ = statsd.Incr(metric.CollectorCount, append(c.tags, tag.Entity(entity)), 1)
+		c.rl.Take()
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("%s stream type conversion error: %T", entity, obj)
+		}
+
+		if !c.nsFilter.Allowed(u.GetNamespace()) {
+			return nil
+		}
+
+		err := ingest(ctx, u)
+		if err != nil {
+			return fmt.Errorf("processing K8s %s %s for namespace %s: %w", entity, u.GetName(), u.GetNamespace(), err)
+		}
+
+		return nil
+	})
+	if errors.IsNotFound(err) {
+		// The custom resource definition is not installed in the cluster
+		c.log.Infof("Resource %s not served by the cluster, skipping %s collection", gvr.GroupResource(), entity)
+
+		return nil
+	}
+
+	return err
+}
+
+// streamDynamicKind streams all the namespaced objects of a custom resource kind across all namespaces via the dynamic
+// client, converting each object to its typed representation (see streamDynamic).
+func streamDynamicKind[T any](ctx context.Context, c *k8sAPICollector, entity string, gvr schema.GroupVersionResource,
+	ingest func(context.Context, *T) error) error {
+
+	return streamDynamic(ctx, c, entity, gvr, func(ctx context.Context, u *unstructured.Unstructured) error {
+		item := new(T)
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), item)
+		if err != nil {
+			return fmt.Errorf("converting K8s %s: %w", entity, err)
+		}
+
+		return ingest(ctx, item)
+	})
+}
+
+// StreamIngresses streams the ingresses, Gateway API gateways and HTTP routes of all namespaces. Gateways are streamed
+// before the HTTP routes attached to them.
+func (c *k8sAPICollector) StreamIngresses(ctx context.Context, ingestor IngressIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityIngresses)
+	defer span.Finish()
+
+	// passing an empty namespace will collect all namespaces
+	err := streamKind(ctx, c, tag.EntityIngresses,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.clientset.NetworkingV1().Ingresses("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *networkingv1.Ingress) error {
+			return ingestor.IngestIngress(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamDynamicKind(ctx, c, tag.EntityGateways, gatewayapi.GatewayResource,
+		func(ctx context.Context, item *gatewayapi.Gateway) error {
+			return ingestor.IngestGateway(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamDynamicKind(ctx, c, tag.EntityHTTPRoutes, gatewayapi.HTTPRouteResource,
+		func(ctx context.Context, item *gatewayapi.HTTPRoute) error {
+			return ingestor.IngestHTTPRoute(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
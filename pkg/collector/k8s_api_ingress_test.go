@@ -0,0 +1,127 @@
+package collector
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newTestDynamicClient creates a fake dynamic client serving the Gateway API resources.
+func newTestDynamicClient() *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			gatewayapi.GatewayResource:                 "GatewayList",
+			gatewayapi.HTTPRouteResource:               "HTTPRouteList",
+			customResourceGVR(testApplicationResource): "ApplicationList",
+			customResourceGVR(testIssuerResource):      "ClusterIssuerList",
+		})
+}
+
+func fakeIngress(name string, namespace string) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
+
+func fakeGatewayObject(kind string, name string, namespace string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": gatewayapi.GroupName + "/" + gatewayapi.Version,
+			"kind":       kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func Test_k8sAPICollector_StreamIngresses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c, ok := NewTestK8sAPICollector(ctx, fake.NewSimpleClientset(fakeIngress("ingress1", "namespace1"))).(*k8sAPICollector)
+	assert.True(t, ok)
+	// Objects are added to the tracker with an explicit resource as the fake client cannot guess the plural of gateway
+	dc := newTestDynamicClient()
+	err := dc.Tracker().Create(gatewayapi.GatewayResource, fakeGatewayObject("Gateway", "gateway1", "namespace1", map[string]any{
+		"gatewayClassName": "external",
+		"listeners": []any{
+			map[string]any{"name": "https", "hostname": "*.example.com", "port": int64(443), "protocol": "HTTPS"},
+		},
+	}), "namespace1")
+	assert.NoError(t, err)
+	err = dc.Tracker().Create(gatewayapi.HTTPRouteResource, fakeGatewayObject("HTTPRoute", "route1", "namespace2", map[string]any{
+		"parentRefs": []any{
+			map[string]any{"name": "gateway1", "namespace": "namespace1"},
+		},
+		"rules": []any{
+			map[string]any{"backendRefs": []any{
+				map[string]any{"name": "service1", "port": int64(8080)},
+			}},
+		},
+	}), "namespace2")
+	assert.NoError(t, err)
+	c.dynamic = dc
+
+	m := mocks.NewIngressIngestor(t)
+	m.EXPECT().IngestIngress(mock.Anything, mock.AnythingOfType("types.IngressType")).Return(nil).Once()
+	m.EXPECT().IngestGateway(mock.Anything, mock.AnythingOfType("types.GatewayType")).
+		RunAndReturn(func(_ context.Context, gw types.GatewayType) error {
+			assert.Equal(t, "external", gw.Spec.GatewayClassName)
+			assert.Len(t, gw.Spec.Listeners, 1)
+			assert.Equal(t, "*.example.com", *gw.Spec.Listeners[0].Hostname)
+
+			return nil
+		}).Once()
+	m.EXPECT().IngestHTTPRoute(mock.Anything, mock.AnythingOfType("types.HTTPRouteType")).
+		RunAndReturn(func(_ context.Context, route types.HTTPRouteType) error {
+			assert.Equal(t, "gateway1", route.Spec.ParentRefs[0].Name)
+			assert.Equal(t, "service1", route.Spec.Rules[0].BackendRefs[0].Name)
+			assert.Equal(t, int32(8080), *route.Spec.Rules[0].BackendRefs[0].Port)
+
+			return nil
+		}).Once()
+	m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, c.StreamIngresses(ctx, m))
+}
+
+func Test_k8sAPICollector_StreamIngressesNoGatewayAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c, ok := NewTestK8sAPICollector(ctx, fake.NewSimpleClientset(fakeIngress("ingress1", "namespace1"))).(*k8sAPICollector)
+	assert.True(t, ok)
+
+	// Clusters without the Gateway API custom resource definitions do not serve the resources
+	dc := newTestDynamicClient()
+	dc.PrependReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), "")
+	})
+	c.dynamic = dc
+
+	m := mocks.NewIngressIngestor(t)
+	m.EXPECT().IngestIngress(mock.Anything, mock.AnythingOfType("types.IngressType")).Return(nil).Once()
+	m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, c.StreamIngresses(ctx, m))
+}
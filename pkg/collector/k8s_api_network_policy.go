@@ -0,0 +1,33 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StreamNetworkPolicies streams the network policies of all namespaces.
+func (c *k8sAPICollector) StreamNetworkPolicies(ctx context.Context, ingestor NetworkPolicyIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityNetworkPolicies)
+	defer span.Finish()
+
+	// passing an empty namespace will collect all namespaces
+	err := streamKind(ctx, c, tag.EntityNetworkPolicies,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.clientset.NetworkingV1().NetworkPolicies("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *networkingv1.NetworkPolicy) error {
+			return ingestor.IngestNetworkPolicy(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
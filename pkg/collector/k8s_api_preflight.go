@@ -0,0 +1,193 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	preflightVerb = "list"
+)
+
+// resourceAccess describes a K8s resource listed by the live collectors to stream an entity type.
+type resourceAccess struct {
+	entity   string // Entity tag of the collector stream
+	group    string // API group of the resource
+	resource string // Resource name
+	required bool   // Whether the resource is required to build the core attack graph (see preflight)
+}
+
+// String returns the resource name qualified by its API group.
+func (r resourceAccess) String() string {
+	if r.group == "" {
+		return r.resource
+	}
+
+	return r.resource + "." + r.group
+}
+
+// k8sResourceAccess holds all the resources listed by the K8s live collector. The resources of the optional entity types
+// (which may not be served by the cluster, e.g gateways, or restricted, e.g secrets) are not required.
+var k8sResourceAccess = []resourceAccess{
+	{entity: tag.EntityNamespaces, group: "", resource: "namespaces"},
+	{entity: tag.EntityNodes, group: "", resource: "nodes", required: true},
+	{entity: tag.EntityPods, group: "", resource: "pods", required: true},
+	{entity: tag.EntityRoles, group: "rbac.authorization.k8s.io", resource: "roles", required: true},
+	{entity: tag.EntityRolebindings, group: "rbac.authorization.k8s.io", resource: "rolebindings", required: true},
+	{entity: tag.EntityClusterRoles, group: "rbac.authorization.k8s.io", resource: "clusterroles", required: true},
+	{entity: tag.EntityClusterRolebindings, group: "rbac.authorization.k8s.io", resource: "clusterrolebindings", required: true},
+	{entity: tag.EntityEndpoints, group: "discovery.k8s.io", resource: "endpointslices", required: true},
+	{entity: tag.EntityServices, group: "", resource: "services"},
+	{entity: tag.EntityDeployments, group: "apps", resource: "deployments"},
+	{entity: tag.EntityDaemonSets, group: "apps", resource: "daemonsets"},
+	{entity: tag.EntityStatefulSets, group: "apps", resource: "statefulsets"},
+	{entity: tag.EntityReplicaSets, group: "apps", resource: "replicasets"},
+	{entity: tag.EntityJobs, group: "batch", resource: "jobs"},
+	{entity: tag.EntityCronJobs, group: "batch", resource: "cronjobs"},
+	{entity: tag.EntityIngresses, group: "networking.k8s.io", resource: "ingresses"},
+	{entity: tag.EntityGateways, group: "gateway.networking.k8s.io", resource: "gateways"},
+	{entity: tag.EntityHTTPRoutes, group: "gateway.networking.k8s.io", resource: "httproutes"},
+	{entity: tag.EntityNetworkPolicies, group: "networking.k8s.io", resource: "networkpolicies"},
+	{entity: tag.EntitySecrets, group: "", resource: "secrets"},
+	{entity: tag.EntityMutatingWebhooks, group: "admissionregistration.k8s.io", resource: "mutatingwebhookconfigurations"},
+	{entity: tag.EntityValidatingWebhooks, group: "admissionregistration.k8s.io", resource: "validatingwebhookconfigurations"},
+}
+
+// openShiftResourceAccess holds the additional resources listed by the OpenShift live collector.
+var openShiftResourceAccess = []resourceAccess{
+	{entity: tag.EntityRoutes, group: "route.openshift.io", resource: "routes", required: true},
+	{entity: tag.EntityGroups, group: "user.openshift.io", resource: "groups"},
+}
+
+// accessReview holds the result of the access review of a single resource.
+type accessReview struct {
+	resourceAccess
+	allowed bool
+	reason  string
+}
+
+// checkPreflightMode validates the configured preflight mode.
+func checkPreflightMode(mode string) error {
+	switch mode {
+	case "", config.PreflightModeFail, config.PreflightModeSkip:
+		return nil
+	default:
+		return fmt.Errorf("invalid collector preflight mode: %s", mode)
+	}
+}
+
+// reviewAccess checks whether the collector identity is allowed to list every resource of the collector, cluster wide,
+// via SelfSubjectAccessReviews.
+func (c *k8sAPICollector) reviewAccess(ctx context.Context) ([]accessReview, error) {
+	reviews := make([]accessReview, 0, len(c.resources))
+	for _, r := range c.resources {
+		ssar := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:     preflightVerb,
+					Group:    r.group,
+					Resource: r.resource,
+				},
+			},
+		}
+
+		res, err := c.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, ssar, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("reviewing %s access on %s: %w", preflightVerb, r, err)
+		}
+
+		reviews = append(reviews, accessReview{
+			resourceAccess: r,
+			allowed:        res.Status.Allowed,
+			reason:         res.Status.Reason,
+		})
+	}
+
+	return reviews, nil
+}
+
+// formatAccessReviews renders the access reviews as a table of granted and missing permissions.
+func formatAccessReviews(reviews []accessReview) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ENTITY\tRESOURCE\tVERB\tACCESS")
+	for _, r := range reviews {
+		access := "granted"
+		switch {
+		case r.allowed:
+			// NOP
+		case r.required:
+			access = "missing"
+		default:
+			access = "missing (optional)"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.entity, r, preflightVerb, access)
+	}
+	_ = w.Flush()
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// preflight checks the collector identity permissions before any data is streamed. Missing permissions on optional
+// resources always mark the corresponding entity types to be skipped. Depending on the configured mode, missing
+// permissions on required resources either fail the collection or mark the corresponding entity types to be skipped.
+func (c *k8sAPICollector) preflight(ctx context.Context) error {
+	reviews, err := c.reviewAccess(ctx)
+	if err != nil {
+		return fmt.Errorf("collector permission preflight: %w", err)
+	}
+
+	c.log.Info("Collector permission preflight:")
+	for _, line := range strings.Split(formatAccessReviews(reviews), "\n") {
+		c.log.Info(line)
+	}
+
+	missingRequired := make([]string, 0)
+	for _, r := range reviews {
+		if !r.allowed && r.required {
+			missingRequired = append(missingRequired, r.String())
+		}
+	}
+
+	if len(missingRequired) != 0 && c.cfg.Preflight != config.PreflightModeSkip {
+		return fmt.Errorf("collector permission preflight: missing %s permission on %s (set the collector preflight mode to %s to collect the remaining entities)",
+			preflightVerb, strings.Join(missingRequired, ", "), config.PreflightModeSkip)
+	}
+
+	missing := make([]string, 0)
+	c.denied = make(map[string]struct{})
+	for _, r := range reviews {
+		if !r.allowed {
+			missing = append(missing, r.String())
+			c.denied[r.entity] = struct{}{}
+		}
+	}
+
+	if len(missing) != 0 {
+		c.log.Warnf("Missing %s permission on %s, the corresponding entities will NOT be collected",
+			preflightVerb, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// accessDenied returns whether the collection of the entity type must be skipped due to missing permissions.
+func (c *k8sAPICollector) accessDenied(entity string) bool {
+	if _, ok := c.denied[entity]; !ok {
+		return false
+	}
+
+	c.log.Warnf("Skipping %s collection due to missing %s permission", entity, preflightVerb)
+
+	return true
+}
@@ -0,0 +1,156 @@
+//nolint:containedctx
+package collector
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newPreflightClientset creates a fake clientset answering access reviews, denying list on the provided resources.
+func newPreflightClientset(denied ...string) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ssar, ok := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		if !ok {
+			return false, nil, nil
+		}
+
+		ssar.Status.Allowed = true
+		for _, r := range denied {
+			if ssar.Spec.ResourceAttributes.Resource == r {
+				ssar.Status.Allowed = false
+			}
+		}
+
+		return true, ssar, nil
+	})
+
+	return clientset
+}
+
+func Test_k8sAPICollector_preflight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		mode       string
+		denied     []string
+		wantErr    bool
+		wantDenied []string
+	}{
+		{
+			name: "all granted",
+			mode: config.PreflightModeFail,
+		},
+		{
+			name:    "missing permission default mode",
+			mode:    "",
+			denied:  []string{"endpointslices"},
+			wantErr: true,
+		},
+		{
+			name:    "missing permission fail mode",
+			mode:    config.PreflightModeFail,
+			denied:  []string{"endpointslices", "routes"},
+			wantErr: true,
+		},
+		{
+			name:       "missing optional permission default mode",
+			mode:       "",
+			denied:     []string{"secrets", "gateways"},
+			wantDenied: []string{tag.EntitySecrets, tag.EntityGateways},
+		},
+		{
+			name:    "missing required and optional permission fail mode",
+			mode:    config.PreflightModeFail,
+			denied:  []string{"endpointslices", "secrets"},
+			wantErr: true,
+		},
+		{
+			name:       "missing permission skip mode",
+			mode:       config.PreflightModeSkip,
+			denied:     []string{"endpointslices", "routes"},
+			wantDenied: []string{"endpoints", "routes"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			c, ok := NewTestK8sAPICollector(ctx, newPreflightClientset(tt.denied...)).(*k8sAPICollector)
+			assert.True(t, ok)
+			c.cfg.Preflight = tt.mode
+			c.resources = append(append([]resourceAccess{}, k8sResourceAccess...), openShiftResourceAccess...)
+
+			err := c.preflight(ctx)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "endpointslices.discovery.k8s.io")
+				assert.NotContains(t, err.Error(), "secrets")
+				assert.Empty(t, c.denied)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, c.denied, len(tt.wantDenied))
+			for _, entity := range tt.wantDenied {
+				assert.True(t, c.accessDenied(entity))
+			}
+		})
+	}
+}
+
+func Test_k8sAPICollector_preflightSkip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, ok := NewTestK8sAPICollector(ctx, newPreflightClientset("endpointslices")).(*k8sAPICollector)
+	assert.True(t, ok)
+	c.cfg.Preflight = config.PreflightModeSkip
+	c.resources = k8sResourceAccess
+
+	assert.NoError(t, c.preflight(ctx))
+
+	// The skipped stream completes without any ingestion
+	ingestor := mocks.NewEndpointIngestor(t)
+	ingestor.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamEndpoints(ctx, ingestor))
+}
+
+func Test_formatAccessReviews(t *testing.T) {
+	t.Parallel()
+
+	got := formatAccessReviews([]accessReview{
+		{resourceAccess: resourceAccess{entity: tag.EntityPods, resource: "pods"}, allowed: true},
+		{resourceAccess: resourceAccess{entity: tag.EntityEndpoints, group: "discovery.k8s.io", resource: "endpointslices", required: true}, allowed: false},
+		{resourceAccess: resourceAccess{entity: tag.EntitySecrets, resource: "secrets"}, allowed: false},
+	})
+
+	want := "ENTITY     RESOURCE                         VERB  ACCESS\n" +
+		"pods       pods                             list  granted\n" +
+		"endpoints  endpointslices.discovery.k8s.io  list  missing\n" +
+		"secrets    secrets                          list  missing (optional)"
+	assert.Equal(t, want, got)
+}
+
+func Test_checkPreflightMode(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, checkPreflightMode(""))
+	assert.NoError(t, checkPreflightMode(config.PreflightModeFail))
+	assert.NoError(t, checkPreflightMode(config.PreflightModeSkip))
+	assert.Error(t, checkPreflightMode("ignore"))
+}
@@ -0,0 +1,96 @@
+package collector
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// collectedSecretTypes holds the types of the token secrets collected from the K8s API by their metadata only, i.e the
+// token secrets whose identity can be derived from their metadata.
+var collectedSecretTypes = []corev1.SecretType{
+	corev1.SecretTypeServiceAccountToken,
+	corev1.SecretTypeBootstrapToken,
+}
+
+// StreamSecrets streams the token and kubeconfig secrets of all namespaces. Only the metadata of the token secrets is
+// requested from the API (one list per secret type, filtered server side) so that their data is never transferred to
+// KubeHound. The kubeconfig secrets cannot be told apart from the other secrets by their type, so the remaining secrets
+// are listed with their data to resolve the identity of the kubeconfig they hold (if any). All secrets are redacted
+// before being passed to the ingestor, and the secrets not holding a kubeconfig are dropped.
+func (c *k8sAPICollector) StreamSecrets(ctx context.Context, ingestor SecretIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntitySecrets)
+	defer span.Finish()
+
+	secrets := c.metadata.Resource(corev1.SchemeGroupVersion.WithResource("secrets"))
+	for _, secretType := range collectedSecretTypes {
+		secretType := secretType
+		selector := fields.OneTermEqualSelector("type", string(secretType)).String()
+
+		// passing an empty namespace will collect all namespaces
+		err := streamKind(ctx, c, tag.EntitySecrets,
+			func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+
+				return secrets.Namespace("").List(ctx, opts)
+			},
+			func(ctx context.Context, item *metav1.PartialObjectMetadata) error {
+				// The type is not part of the metadata, but is guaranteed by the field selector of the list
+				secret := &corev1.Secret{
+					ObjectMeta: item.ObjectMeta,
+					Type:       secretType,
+				}
+
+				// Token secrets hold no kubeconfig, this only drops any kubeconfig identity annotation set on them
+				libkube.ResolveKubeconfigSecret(secret)
+				libkube.RedactSecret(secret)
+
+				return ingestor.IngestSecret(ctx, secret)
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.streamKubeconfigSecrets(ctx, ingestor)
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
+
+// streamKubeconfigSecrets streams the secrets of all namespaces holding a kubeconfig whose identity can be resolved.
+func (c *k8sAPICollector) streamKubeconfigSecrets(ctx context.Context, ingestor SecretIngestor) error {
+	selectors := make([]string, 0, len(collectedSecretTypes))
+	for _, secretType := range collectedSecretTypes {
+		selectors = append(selectors, fields.OneTermNotEqualSelector("type", string(secretType)).String())
+	}
+	selector := strings.Join(selectors, ",")
+
+	// passing an empty namespace will collect all namespaces
+	return streamKind(ctx, c, tag.EntitySecrets,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			opts.FieldSelector = selector
+
+			return c.clientset.CoreV1().Secrets("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *corev1.Secret) error {
+			resolved := libkube.ResolveKubeconfigSecret(item)
+			libkube.RedactSecret(item)
+			if !resolved {
+				return nil
+			}
+
+			return ingestor.IngestSecret(ctx, item)
+		})
+}
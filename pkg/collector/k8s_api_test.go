@@ -3,20 +3,28 @@ package collector
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
 	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
 	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/telemetry/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/ratelimit"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func NewTestK8sAPICollector(ctx context.Context, clientset *fake.Clientset) CollectorClient {
@@ -29,6 +37,8 @@ func NewTestK8sAPICollector(ctx context.Context, clientset *fake.Clientset) Coll
 	return &k8sAPICollector{
 		cfg:       cfg,
 		clientset: clientset,
+		dynamic:   newTestDynamicClient(),
+		metadata:  newTestMetadataClient(),
 		log:       log.Trace(ctx, log.WithComponent(K8sAPICollectorName)),
 		rl:        ratelimit.New(config.DefaultK8sAPIRateLimitPerSecond), // per second
 	}
@@ -460,6 +470,86 @@ func Test_k8sAPICollector_StreamNodes(t *testing.T) {
 	}
 }
 
+func fakeNamespace(name string, level string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"pod-security.kubernetes.io/enforce": level,
+			},
+		},
+	}
+}
+
+func Test_k8sAPICollector_StreamNamespaces(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 0 namespaces found
+	test1 := func(t *testing.T) (*fake.Clientset, *mocks.NamespaceIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset()
+		m := mocks.NewNamespaceIngestor(t)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, m
+	}
+
+	// Listing all the namespaces in the cluster
+	test2 := func(t *testing.T) (*fake.Clientset, *mocks.NamespaceIngestor) {
+		t.Helper()
+		clienset := fake.NewSimpleClientset(
+			[]runtime.Object{
+				fakeNamespace("namespace1", "baseline"),
+				fakeNamespace("namespace2", "privileged"),
+			}...,
+		)
+		m := mocks.NewNamespaceIngestor(t)
+		m.EXPECT().IngestNamespace(mock.Anything, mock.AnythingOfType("types.NamespaceType")).Return(nil).Twice()
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clienset, m
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		testfct func(t *testing.T) (*fake.Clientset, *mocks.NamespaceIngestor)
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no entry",
+			testfct: test1,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "all namespaces",
+			testfct: test2,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clientset, mock := tt.testfct(t)
+			c := NewTestK8sAPICollector(tt.args.ctx, clientset)
+			if err := c.StreamNamespaces(tt.args.ctx, mock); (err != nil) != tt.wantErr {
+				t.Errorf("k8sAPICollector.StreamNamespaces() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func fakeClusterRole(name string) *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -709,3 +799,485 @@ func Test_k8sAPICollector_StreamEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func fakeService(name string, namespace string, serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+		},
+	}
+}
+
+func Test_k8sAPICollector_StreamServices(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 0 services found
+	test1 := func(t *testing.T) (*fake.Clientset, *mocks.ServiceIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset()
+		m := mocks.NewServiceIngestor(t)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, m
+	}
+
+	// Listing all the services from all namespaces
+	test2 := func(t *testing.T) (*fake.Clientset, *mocks.ServiceIngestor) {
+		t.Helper()
+		clienset := fake.NewSimpleClientset(
+			[]runtime.Object{
+				fakeService("name1", "namespace1", corev1.ServiceTypeClusterIP),
+				fakeService("name2", "namespace2", corev1.ServiceTypeLoadBalancer),
+			}...,
+		)
+		m := mocks.NewServiceIngestor(t)
+		m.EXPECT().IngestService(mock.Anything, mock.AnythingOfType("types.ServiceType")).Return(nil).Twice()
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clienset, m
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		testfct func(t *testing.T) (*fake.Clientset, *mocks.ServiceIngestor)
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no entry",
+			testfct: test1,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "all namespace",
+			testfct: test2,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clientset, mock := tt.testfct(t)
+			c := NewTestK8sAPICollector(tt.args.ctx, clientset)
+			if err := c.StreamServices(tt.args.ctx, mock); (err != nil) != tt.wantErr {
+				t.Errorf("k8sAPICollector.StreamServices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func fakeNetworkPolicy(name string, namespace string) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+func Test_k8sAPICollector_StreamNetworkPolicies(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 0 network policies found
+	test1 := func(t *testing.T) (*fake.Clientset, *mocks.NetworkPolicyIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset()
+		m := mocks.NewNetworkPolicyIngestor(t)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, m
+	}
+
+	// Listing all the network policies from all namespaces
+	test2 := func(t *testing.T) (*fake.Clientset, *mocks.NetworkPolicyIngestor) {
+		t.Helper()
+		clienset := fake.NewSimpleClientset(
+			[]runtime.Object{
+				fakeNetworkPolicy("name1", "namespace1"),
+				fakeNetworkPolicy("name2", "namespace2"),
+			}...,
+		)
+		m := mocks.NewNetworkPolicyIngestor(t)
+		m.EXPECT().IngestNetworkPolicy(mock.Anything, mock.AnythingOfType("types.NetworkPolicyType")).Return(nil).Twice()
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clienset, m
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		testfct func(t *testing.T) (*fake.Clientset, *mocks.NetworkPolicyIngestor)
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no entry",
+			testfct: test1,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "all namespace",
+			testfct: test2,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clientset, mock := tt.testfct(t)
+			c := NewTestK8sAPICollector(tt.args.ctx, clientset)
+			if err := c.StreamNetworkPolicies(tt.args.ctx, mock); (err != nil) != tt.wantErr {
+				t.Errorf("k8sAPICollector.StreamNetworkPolicies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func fakeSecret(name string, namespace string) *corev1.Secret {
+	return fakeTypedSecret(name, namespace, corev1.SecretTypeServiceAccountToken)
+}
+
+func fakeTypedSecret(name string, namespace string, secretType corev1.SecretType) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey:       "sa1",
+				corev1.LastAppliedConfigAnnotation: `{"data":{"token":"dG9rZW4="}}`,
+			},
+		},
+		Type: secretType,
+		Data: map[string][]byte{"token": []byte("token")},
+	}
+}
+
+// newTestMetadataClient creates a fake metadata client serving the metadata of the provided secrets. The fake client
+// does not support field selectors, so the secrets are filtered on their type by a dedicated reactor.
+func newTestMetadataClient(secrets ...*corev1.Secret) *metadatafake.FakeMetadataClient {
+	mc := metadatafake.NewSimpleMetadataClient(metadatafake.NewTestScheme())
+	mc.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		restrictions := action.(k8stesting.ListAction).GetListRestrictions()
+		list := &metav1.List{}
+		for _, secret := range secrets {
+			if !restrictions.Fields.Matches(fields.Set{"type": string(secret.Type)}) {
+				continue
+			}
+
+			list.Items = append(list.Items, runtime.RawExtension{
+				Object: &metav1.PartialObjectMetadata{ObjectMeta: secret.ObjectMeta},
+			})
+		}
+
+		return true, list, nil
+	})
+
+	return mc
+}
+
+// fakeKubeconfigSecret returns an opaque secret holding a kubeconfig authenticating with a service account token.
+func fakeKubeconfigSecret(name string, namespace string, subject string) *corev1.Secret {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + subject + `"}`))
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://127.0.0.1:6443
+users:
+- name: test
+  user:
+    token: eyJhbGciOiJub25lIn0.` + payload + `.signature
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+`
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{"kubeconfig": []byte(kubeconfig)},
+	}
+}
+
+func Test_k8sAPICollector_StreamSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 0 secrets found
+	test1 := func(t *testing.T) (*fake.Clientset, *metadatafake.FakeMetadataClient, *mocks.SecretIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset()
+		mc := newTestMetadataClient()
+		m := mocks.NewSecretIngestor(t)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, mc, m
+	}
+
+	// Listing the token secrets from all namespaces, with their type and without their data
+	test2 := func(t *testing.T) (*fake.Clientset, *metadatafake.FakeMetadataClient, *mocks.SecretIngestor) {
+		t.Helper()
+		forged := fakeSecret("name2", "namespace2")
+		forged.Annotations[libkube.KubeconfigUserAnnotation] = "admin"
+
+		clientset := fake.NewSimpleClientset()
+		mc := newTestMetadataClient(
+			fakeSecret("name1", "namespace1"),
+			forged,
+			fakeTypedSecret("bootstrap-token-abcdef", "kube-system", corev1.SecretTypeBootstrapToken),
+			fakeTypedSecret("name3", "namespace1", corev1.SecretTypeOpaque),
+		)
+		m := mocks.NewSecretIngestor(t)
+		m.EXPECT().IngestSecret(mock.Anything, mock.AnythingOfType("types.SecretType")).
+			RunAndReturn(func(_ context.Context, secret types.SecretType) error {
+				assert.Nil(t, secret.Data)
+				assert.Equal(t, map[string]string{corev1.ServiceAccountNameKey: "sa1"}, secret.Annotations)
+				if secret.Name == "bootstrap-token-abcdef" {
+					assert.Equal(t, corev1.SecretTypeBootstrapToken, secret.Type)
+				} else {
+					assert.Equal(t, corev1.SecretTypeServiceAccountToken, secret.Type)
+				}
+
+				return nil
+			}).Times(3)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, mc, m
+	}
+
+	// Listing the other secrets from all namespaces with their data, only keeping the redacted kubeconfig secrets
+	test3 := func(t *testing.T) (*fake.Clientset, *metadatafake.FakeMetadataClient, *mocks.SecretIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset(
+			fakeKubeconfigSecret("kubeconfig1", "namespace1", "system:serviceaccount:namespace2:deployer"),
+			fakeTypedSecret("name3", "namespace1", corev1.SecretTypeOpaque),
+		)
+		mc := newTestMetadataClient()
+		m := mocks.NewSecretIngestor(t)
+		m.EXPECT().IngestSecret(mock.Anything, mock.AnythingOfType("types.SecretType")).
+			RunAndReturn(func(_ context.Context, secret types.SecretType) error {
+				assert.Equal(t, "kubeconfig1", secret.Name)
+				assert.Nil(t, secret.Data)
+				assert.Equal(t, map[string]string{
+					libkube.KubeconfigUserAnnotation: "system:serviceaccount:namespace2:deployer",
+				}, secret.Annotations)
+
+				return nil
+			}).Once()
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, mc, m
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		testfct func(t *testing.T) (*fake.Clientset, *metadatafake.FakeMetadataClient, *mocks.SecretIngestor)
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no entry",
+			testfct: test1,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "all namespace",
+			testfct: test2,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "kubeconfig secrets",
+			testfct: test3,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clientset, mc, mock := tt.testfct(t)
+			c, ok := NewTestK8sAPICollector(tt.args.ctx, clientset).(*k8sAPICollector)
+			assert.True(t, ok)
+			c.metadata = mc
+			if err := c.StreamSecrets(tt.args.ctx, mock); (err != nil) != tt.wantErr {
+				t.Errorf("k8sAPICollector.StreamSecrets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_k8sAPICollector_StreamWebhookConfigurations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 0 webhook configurations found
+	test1 := func(t *testing.T) (*fake.Clientset, *mocks.WebhookConfigurationIngestor) {
+		t.Helper()
+		clientset := fake.NewSimpleClientset()
+		m := mocks.NewWebhookConfigurationIngestor(t)
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clientset, m
+	}
+
+	// Listing both mutating and validating webhook configurations
+	test2 := func(t *testing.T) (*fake.Clientset, *mocks.WebhookConfigurationIngestor) {
+		t.Helper()
+		clienset := fake.NewSimpleClientset(
+			[]runtime.Object{
+				&admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "mutating1"}},
+				&admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "mutating2"}},
+				&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "validating1"}},
+			}...,
+		)
+		m := mocks.NewWebhookConfigurationIngestor(t)
+		m.EXPECT().IngestMutatingWebhookConfiguration(mock.Anything, mock.AnythingOfType("types.MutatingWebhookConfigurationType")).Return(nil).Twice()
+		m.EXPECT().IngestValidatingWebhookConfiguration(mock.Anything, mock.AnythingOfType("types.ValidatingWebhookConfigurationType")).Return(nil).Once()
+		m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+		return clienset, m
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		testfct func(t *testing.T) (*fake.Clientset, *mocks.WebhookConfigurationIngestor)
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no entry",
+			testfct: test1,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "mutating and validating",
+			testfct: test2,
+			args: args{
+				ctx: ctx,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clientset, mock := tt.testfct(t)
+			c := NewTestK8sAPICollector(tt.args.ctx, clientset)
+			if err := c.StreamWebhookConfigurations(tt.args.ctx, mock); (err != nil) != tt.wantErr {
+				t.Errorf("k8sAPICollector.StreamWebhookConfigurations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_k8sAPICollector_APIResources(t *testing.T) {
+	t.Parallel()
+
+	clientset := fake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Verbs: metav1.Verbs{"create", "get", "list"}},
+				{Name: "pods/exec", Verbs: metav1.Verbs{"create", "get"}},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	c := NewTestK8sAPICollector(ctx, clientset)
+
+	lists, err := c.APIResources(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, lists, 1)
+	assert.Equal(t, "v1", lists[0].GroupVersion)
+	assert.Len(t, lists[0].APIResources, 2)
+}
+
+func Test_k8sAPICollector_NamespaceFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clientset := fake.NewSimpleClientset(
+		[]runtime.Object{
+			fakePod("namespace1", "image1"),
+			fakePod("namespace2", "image2"),
+			fakeRole("namespace1", "name1"),
+			fakeRole("kube-system", "name2"),
+		}...,
+	)
+
+	nsFilter, err := newNamespaceFilter(&config.NamespaceFilterConfig{
+		Include: []string{"namespace*"},
+		Exclude: []string{"/2$/"},
+	})
+	assert.NoError(t, err)
+
+	c := NewTestK8sAPICollector(ctx, clientset).(*k8sAPICollector) //nolint:forcetypeassert
+	c.nsFilter = nsFilter
+
+	pods := mocks.NewPodIngestor(t)
+	pods.EXPECT().IngestPod(mock.Anything, mock.AnythingOfType("types.PodType")).Return(nil).Once()
+	pods.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamPods(ctx, pods))
+
+	roles := mocks.NewRoleIngestor(t)
+	roles.EXPECT().IngestRole(mock.Anything, mock.AnythingOfType("types.RoleType")).Return(nil).Once()
+	roles.EXPECT().Complete(mock.Anything).Return(nil).Once()
+	assert.NoError(t, c.StreamRoles(ctx, roles))
+}
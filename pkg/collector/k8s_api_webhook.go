@@ -0,0 +1,43 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StreamWebhookConfigurations streams the mutating and validating admission webhook configurations of the cluster.
+func (c *k8sAPICollector) StreamWebhookConfigurations(ctx context.Context, ingestor WebhookConfigurationIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityMutatingWebhooks)
+	defer span.Finish()
+
+	err := streamKind(ctx, c, tag.EntityMutatingWebhooks,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, opts)
+		},
+		func(ctx context.Context, item *admissionregistrationv1.MutatingWebhookConfiguration) error {
+			return ingestor.IngestMutatingWebhookConfiguration(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityValidatingWebhooks,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, opts)
+		},
+		func(ctx context.Context, item *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+			return ingestor.IngestValidatingWebhookConfiguration(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
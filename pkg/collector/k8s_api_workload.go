@@ -0,0 +1,93 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StreamWorkloads streams the workload controllers of all namespaces. Owning controllers are streamed before the
+// controllers they manage (deployments before replica sets, cron jobs before jobs).
+func (c *k8sAPICollector) StreamWorkloads(ctx context.Context, ingestor WorkloadIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityWorkloads)
+	defer span.Finish()
+
+	apps := c.clientset.AppsV1()
+	batch := c.clientset.BatchV1()
+
+	// passing an empty namespace will collect all namespaces
+	err := streamKind(ctx, c, tag.EntityDeployments,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return apps.Deployments("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *appsv1.Deployment) error {
+			return ingestor.IngestDeployment(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityDaemonSets,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return apps.DaemonSets("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *appsv1.DaemonSet) error {
+			return ingestor.IngestDaemonSet(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityStatefulSets,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return apps.StatefulSets("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *appsv1.StatefulSet) error {
+			return ingestor.IngestStatefulSet(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityReplicaSets,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return apps.ReplicaSets("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *appsv1.ReplicaSet) error {
+			return ingestor.IngestReplicaSet(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityCronJobs,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return batch.CronJobs("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *batchv1.CronJob) error {
+			return ingestor.IngestCronJob(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = streamKind(ctx, c, tag.EntityJobs,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return batch.Jobs("").List(ctx, opts)
+		},
+		func(ctx context.Context, item *batchv1.Job) error {
+			return ingestor.IngestJob(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
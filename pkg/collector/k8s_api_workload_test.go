@@ -0,0 +1,79 @@
+//nolint:containedctx
+package collector
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/DataDog/KubeHound/pkg/collector/mockingest"
+	"github.com/DataDog/KubeHound/pkg/telemetry/tag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestWorkloadClientset() *fake.Clientset {
+	meta := func(name string, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		}
+	}
+
+	return fake.NewSimpleClientset(
+		[]runtime.Object{
+			&appsv1.Deployment{ObjectMeta: meta("deployment1", "namespace1")},
+			&appsv1.DaemonSet{ObjectMeta: meta("daemonset1", "namespace1")},
+			&appsv1.DaemonSet{ObjectMeta: meta("daemonset2", "namespace2")},
+			&appsv1.StatefulSet{ObjectMeta: meta("statefulset1", "namespace2")},
+			&appsv1.ReplicaSet{ObjectMeta: meta("replicaset1", "namespace1")},
+			&batchv1.Job{ObjectMeta: meta("job1", "namespace1")},
+			&batchv1.CronJob{ObjectMeta: meta("cronjob1", "namespace2")},
+		}...,
+	)
+}
+
+func Test_k8sAPICollector_StreamWorkloads(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := NewTestK8sAPICollector(ctx, newTestWorkloadClientset())
+
+	m := mocks.NewWorkloadIngestor(t)
+	m.EXPECT().IngestDeployment(mock.Anything, mock.AnythingOfType("types.DeploymentType")).Return(nil).Once()
+	m.EXPECT().IngestDaemonSet(mock.Anything, mock.AnythingOfType("types.DaemonSetType")).Return(nil).Twice()
+	m.EXPECT().IngestStatefulSet(mock.Anything, mock.AnythingOfType("types.StatefulSetType")).Return(nil).Once()
+	m.EXPECT().IngestReplicaSet(mock.Anything, mock.AnythingOfType("types.ReplicaSetType")).Return(nil).Once()
+	m.EXPECT().IngestJob(mock.Anything, mock.AnythingOfType("types.JobType")).Return(nil).Once()
+	m.EXPECT().IngestCronJob(mock.Anything, mock.AnythingOfType("types.CronJobType")).Return(nil).Once()
+	m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, c.StreamWorkloads(ctx, m))
+}
+
+func Test_k8sAPICollector_StreamWorkloadsDenied(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c, ok := NewTestK8sAPICollector(ctx, newTestWorkloadClientset()).(*k8sAPICollector)
+	assert.True(t, ok)
+
+	// Kinds without list permission are skipped, the other kinds are still collected
+	c.denied = map[string]struct{}{
+		tag.EntityDaemonSets: {},
+		tag.EntityJobs:       {},
+	}
+
+	m := mocks.NewWorkloadIngestor(t)
+	m.EXPECT().IngestDeployment(mock.Anything, mock.AnythingOfType("types.DeploymentType")).Return(nil).Once()
+	m.EXPECT().IngestStatefulSet(mock.Anything, mock.AnythingOfType("types.StatefulSetType")).Return(nil).Once()
+	m.EXPECT().IngestReplicaSet(mock.Anything, mock.AnythingOfType("types.ReplicaSetType")).Return(nil).Once()
+	m.EXPECT().IngestCronJob(mock.Anything, mock.AnythingOfType("types.CronJobType")).Return(nil).Once()
+	m.EXPECT().Complete(mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, c.StreamWorkloads(ctx, m))
+}
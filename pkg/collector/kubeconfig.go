@@ -0,0 +1,80 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubeClientConfig holds the resolved connection settings of a live collector.
+type kubeClientConfig struct {
+	rest        *rest.Config
+	clusterName string
+}
+
+// loadKubeClientConfig resolves the REST client configuration and the cluster name of the live collectors from the
+// same source: either the in-cluster service account or a single kubeconfig context.
+func loadKubeClientConfig(cfg *config.K8SAPICollectorConfig) (*kubeClientConfig, error) {
+	if cfg.InCluster {
+		rc, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("building in-cluster kubernetes config: %w", err)
+		}
+
+		name := cfg.ClusterName
+		if name == "" {
+			name = config.DefaultClusterName
+		}
+
+		rc.UserAgent = CollectorUserAgent
+
+		return &kubeClientConfig{
+			rest:        rc,
+			clusterName: name,
+		}, nil
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = cfg.Kubeconfig
+	configOverrides := &clientcmd.ConfigOverrides{
+		CurrentContext: cfg.Context,
+	}
+	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+
+	raw, err := kubeConfig.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("raw config get: %w", err)
+	}
+
+	contextName := raw.CurrentContext
+	if cfg.Context != "" {
+		if _, ok := raw.Contexts[cfg.Context]; !ok {
+			return nil, fmt.Errorf("kubeconfig context %s not found", cfg.Context)
+		}
+
+		contextName = cfg.Context
+	}
+
+	rc, err := kubeConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes config: %w", err)
+	}
+
+	rc.UserAgent = CollectorUserAgent
+
+	name := cfg.ClusterName
+	if name == "" {
+		name = contextName
+	}
+
+	if name == "" {
+		name = config.DefaultClusterName
+	}
+
+	return &kubeClientConfig{
+		rest:        rc,
+		clusterName: name,
+	}, nil
+}
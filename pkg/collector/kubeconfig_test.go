@@ -0,0 +1,125 @@
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: cluster-a
+clusters:
+- name: cluster-a
+  cluster:
+    server: https://cluster-a.example.com
+- name: cluster-b
+  cluster:
+    server: https://cluster-b.example.com
+contexts:
+- name: cluster-a
+  context:
+    cluster: cluster-a
+    user: user
+- name: cluster-b
+  context:
+    cluster: cluster-b
+    user: user
+users:
+- name: user
+  user:
+    token: test-token
+`
+
+func writeTestKubeconfig(t *testing.T) string {
+	t.Helper()
+
+	fp := filepath.Join(t.TempDir(), "kubeconfig")
+	err := os.WriteFile(fp, []byte(testKubeconfig), 0o600)
+	assert.NoError(t, err)
+
+	return fp
+}
+
+func TestLoadKubeClientConfig(t *testing.T) {
+	t.Parallel()
+
+	kubeconfig := writeTestKubeconfig(t)
+
+	tests := []struct {
+		name        string
+		cfg         *config.K8SAPICollectorConfig
+		wantHost    string
+		wantCluster string
+		wantErr     bool
+	}{
+		{
+			name: "current context",
+			cfg: &config.K8SAPICollectorConfig{
+				Kubeconfig: kubeconfig,
+			},
+			wantHost:    "https://cluster-a.example.com",
+			wantCluster: "cluster-a",
+		},
+		{
+			name: "explicit context",
+			cfg: &config.K8SAPICollectorConfig{
+				Kubeconfig: kubeconfig,
+				Context:    "cluster-b",
+			},
+			wantHost:    "https://cluster-b.example.com",
+			wantCluster: "cluster-b",
+		},
+		{
+			name: "cluster name override",
+			cfg: &config.K8SAPICollectorConfig{
+				Kubeconfig:  kubeconfig,
+				Context:     "cluster-b",
+				ClusterName: "prod-b",
+			},
+			wantHost:    "https://cluster-b.example.com",
+			wantCluster: "prod-b",
+		},
+		{
+			name: "unknown context",
+			cfg: &config.K8SAPICollectorConfig{
+				Kubeconfig: kubeconfig,
+				Context:    "cluster-c",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kc, err := loadKubeClientConfig(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantHost, kc.rest.Host)
+			assert.Equal(t, tt.wantCluster, kc.clusterName)
+			assert.Equal(t, CollectorUserAgent, kc.rest.UserAgent)
+		})
+	}
+}
+
+//nolint:paralleltest // t.Setenv is not compatible with parallel tests
+func TestLoadKubeClientConfig_InCluster(t *testing.T) {
+	// Not running within a pod, the service account environment is not available
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	_, err := loadKubeClientConfig(&config.K8SAPICollectorConfig{
+		InCluster: true,
+	})
+	assert.ErrorContains(t, err, "in-cluster")
+}
@@ -8,6 +8,8 @@ import (
 	collector "github.com/DataDog/KubeHound/pkg/collector"
 
 	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // CollectorClient is an autogenerated mock type for the CollectorClient type
@@ -23,6 +25,60 @@ func (_m *CollectorClient) EXPECT() *CollectorClient_Expecter {
 	return &CollectorClient_Expecter{mock: &_m.Mock}
 }
 
+// APIResources provides a mock function with given fields: ctx
+func (_m *CollectorClient) APIResources(ctx context.Context) ([]*v1.APIResourceList, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*v1.APIResourceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*v1.APIResourceList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*v1.APIResourceList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.APIResourceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CollectorClient_APIResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIResources'
+type CollectorClient_APIResources_Call struct {
+	*mock.Call
+}
+
+// APIResources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CollectorClient_Expecter) APIResources(ctx interface{}) *CollectorClient_APIResources_Call {
+	return &CollectorClient_APIResources_Call{Call: _e.mock.On("APIResources", ctx)}
+}
+
+func (_c *CollectorClient_APIResources_Call) Run(run func(ctx context.Context)) *CollectorClient_APIResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_APIResources_Call) Return(_a0 []*v1.APIResourceList, _a1 error) *CollectorClient_APIResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CollectorClient_APIResources_Call) RunAndReturn(run func(context.Context) ([]*v1.APIResourceList, error)) *CollectorClient_APIResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields: ctx
 func (_m *CollectorClient) Close(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -298,6 +354,49 @@ func (_c *CollectorClient_StreamClusterRoles_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// StreamCustomResources provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamCustomResources(ctx context.Context, ingestor collector.CustomResourceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.CustomResourceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamCustomResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamCustomResources'
+type CollectorClient_StreamCustomResources_Call struct {
+	*mock.Call
+}
+
+// StreamCustomResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.CustomResourceIngestor
+func (_e *CollectorClient_Expecter) StreamCustomResources(ctx interface{}, ingestor interface{}) *CollectorClient_StreamCustomResources_Call {
+	return &CollectorClient_StreamCustomResources_Call{Call: _e.mock.On("StreamCustomResources", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamCustomResources_Call) Run(run func(ctx context.Context, ingestor collector.CustomResourceIngestor)) *CollectorClient_StreamCustomResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.CustomResourceIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamCustomResources_Call) Return(_a0 error) *CollectorClient_StreamCustomResources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamCustomResources_Call) RunAndReturn(run func(context.Context, collector.CustomResourceIngestor) error) *CollectorClient_StreamCustomResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StreamEndpoints provides a mock function with given fields: ctx, ingestor
 func (_m *CollectorClient) StreamEndpoints(ctx context.Context, ingestor collector.EndpointIngestor) error {
 	ret := _m.Called(ctx, ingestor)
@@ -341,6 +440,135 @@ func (_c *CollectorClient_StreamEndpoints_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// StreamIngresses provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamIngresses(ctx context.Context, ingestor collector.IngressIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.IngressIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamIngresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamIngresses'
+type CollectorClient_StreamIngresses_Call struct {
+	*mock.Call
+}
+
+// StreamIngresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.IngressIngestor
+func (_e *CollectorClient_Expecter) StreamIngresses(ctx interface{}, ingestor interface{}) *CollectorClient_StreamIngresses_Call {
+	return &CollectorClient_StreamIngresses_Call{Call: _e.mock.On("StreamIngresses", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamIngresses_Call) Run(run func(ctx context.Context, ingestor collector.IngressIngestor)) *CollectorClient_StreamIngresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.IngressIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamIngresses_Call) Return(_a0 error) *CollectorClient_StreamIngresses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamIngresses_Call) RunAndReturn(run func(context.Context, collector.IngressIngestor) error) *CollectorClient_StreamIngresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamNamespaces provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamNamespaces(ctx context.Context, ingestor collector.NamespaceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.NamespaceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamNamespaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamNamespaces'
+type CollectorClient_StreamNamespaces_Call struct {
+	*mock.Call
+}
+
+// StreamNamespaces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.NamespaceIngestor
+func (_e *CollectorClient_Expecter) StreamNamespaces(ctx interface{}, ingestor interface{}) *CollectorClient_StreamNamespaces_Call {
+	return &CollectorClient_StreamNamespaces_Call{Call: _e.mock.On("StreamNamespaces", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamNamespaces_Call) Run(run func(ctx context.Context, ingestor collector.NamespaceIngestor)) *CollectorClient_StreamNamespaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.NamespaceIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamNamespaces_Call) Return(_a0 error) *CollectorClient_StreamNamespaces_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamNamespaces_Call) RunAndReturn(run func(context.Context, collector.NamespaceIngestor) error) *CollectorClient_StreamNamespaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamNetworkPolicies provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamNetworkPolicies(ctx context.Context, ingestor collector.NetworkPolicyIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.NetworkPolicyIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamNetworkPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamNetworkPolicies'
+type CollectorClient_StreamNetworkPolicies_Call struct {
+	*mock.Call
+}
+
+// StreamNetworkPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.NetworkPolicyIngestor
+func (_e *CollectorClient_Expecter) StreamNetworkPolicies(ctx interface{}, ingestor interface{}) *CollectorClient_StreamNetworkPolicies_Call {
+	return &CollectorClient_StreamNetworkPolicies_Call{Call: _e.mock.On("StreamNetworkPolicies", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamNetworkPolicies_Call) Run(run func(ctx context.Context, ingestor collector.NetworkPolicyIngestor)) *CollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.NetworkPolicyIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamNetworkPolicies_Call) Return(_a0 error) *CollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamNetworkPolicies_Call) RunAndReturn(run func(context.Context, collector.NetworkPolicyIngestor) error) *CollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StreamNodes provides a mock function with given fields: ctx, ingestor
 func (_m *CollectorClient) StreamNodes(ctx context.Context, ingestor collector.NodeIngestor) error {
 	ret := _m.Called(ctx, ingestor)
@@ -513,6 +741,178 @@ func (_c *CollectorClient_StreamRoles_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// StreamSecrets provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamSecrets(ctx context.Context, ingestor collector.SecretIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.SecretIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSecrets'
+type CollectorClient_StreamSecrets_Call struct {
+	*mock.Call
+}
+
+// StreamSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.SecretIngestor
+func (_e *CollectorClient_Expecter) StreamSecrets(ctx interface{}, ingestor interface{}) *CollectorClient_StreamSecrets_Call {
+	return &CollectorClient_StreamSecrets_Call{Call: _e.mock.On("StreamSecrets", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamSecrets_Call) Run(run func(ctx context.Context, ingestor collector.SecretIngestor)) *CollectorClient_StreamSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.SecretIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamSecrets_Call) Return(_a0 error) *CollectorClient_StreamSecrets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamSecrets_Call) RunAndReturn(run func(context.Context, collector.SecretIngestor) error) *CollectorClient_StreamSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamServices provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamServices(ctx context.Context, ingestor collector.ServiceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.ServiceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamServices'
+type CollectorClient_StreamServices_Call struct {
+	*mock.Call
+}
+
+// StreamServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.ServiceIngestor
+func (_e *CollectorClient_Expecter) StreamServices(ctx interface{}, ingestor interface{}) *CollectorClient_StreamServices_Call {
+	return &CollectorClient_StreamServices_Call{Call: _e.mock.On("StreamServices", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamServices_Call) Run(run func(ctx context.Context, ingestor collector.ServiceIngestor)) *CollectorClient_StreamServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.ServiceIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamServices_Call) Return(_a0 error) *CollectorClient_StreamServices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamServices_Call) RunAndReturn(run func(context.Context, collector.ServiceIngestor) error) *CollectorClient_StreamServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamWebhookConfigurations provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamWebhookConfigurations(ctx context.Context, ingestor collector.WebhookConfigurationIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.WebhookConfigurationIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamWebhookConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamWebhookConfigurations'
+type CollectorClient_StreamWebhookConfigurations_Call struct {
+	*mock.Call
+}
+
+// StreamWebhookConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.WebhookConfigurationIngestor
+func (_e *CollectorClient_Expecter) StreamWebhookConfigurations(ctx interface{}, ingestor interface{}) *CollectorClient_StreamWebhookConfigurations_Call {
+	return &CollectorClient_StreamWebhookConfigurations_Call{Call: _e.mock.On("StreamWebhookConfigurations", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamWebhookConfigurations_Call) Run(run func(ctx context.Context, ingestor collector.WebhookConfigurationIngestor)) *CollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.WebhookConfigurationIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamWebhookConfigurations_Call) Return(_a0 error) *CollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamWebhookConfigurations_Call) RunAndReturn(run func(context.Context, collector.WebhookConfigurationIngestor) error) *CollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamWorkloads provides a mock function with given fields: ctx, ingestor
+func (_m *CollectorClient) StreamWorkloads(ctx context.Context, ingestor collector.WorkloadIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.WorkloadIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollectorClient_StreamWorkloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamWorkloads'
+type CollectorClient_StreamWorkloads_Call struct {
+	*mock.Call
+}
+
+// StreamWorkloads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.WorkloadIngestor
+func (_e *CollectorClient_Expecter) StreamWorkloads(ctx interface{}, ingestor interface{}) *CollectorClient_StreamWorkloads_Call {
+	return &CollectorClient_StreamWorkloads_Call{Call: _e.mock.On("StreamWorkloads", ctx, ingestor)}
+}
+
+func (_c *CollectorClient_StreamWorkloads_Call) Run(run func(ctx context.Context, ingestor collector.WorkloadIngestor)) *CollectorClient_StreamWorkloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.WorkloadIngestor))
+	})
+	return _c
+}
+
+func (_c *CollectorClient_StreamWorkloads_Call) Return(_a0 error) *CollectorClient_StreamWorkloads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectorClient_StreamWorkloads_Call) RunAndReturn(run func(context.Context, collector.WorkloadIngestor) error) *CollectorClient_StreamWorkloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewCollectorClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -8,6 +8,8 @@ import (
 	collector "github.com/DataDog/KubeHound/pkg/collector"
 
 	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // OpenShiftCollectorClient is an autogenerated mock type for the OpenShiftCollectorClient type
@@ -23,6 +25,60 @@ func (_m *OpenShiftCollectorClient) EXPECT() *OpenShiftCollectorClient_Expecter
 	return &OpenShiftCollectorClient_Expecter{mock: &_m.Mock}
 }
 
+// APIResources provides a mock function with given fields: ctx
+func (_m *OpenShiftCollectorClient) APIResources(ctx context.Context) ([]*v1.APIResourceList, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*v1.APIResourceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*v1.APIResourceList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*v1.APIResourceList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.APIResourceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpenShiftCollectorClient_APIResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIResources'
+type OpenShiftCollectorClient_APIResources_Call struct {
+	*mock.Call
+}
+
+// APIResources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OpenShiftCollectorClient_Expecter) APIResources(ctx interface{}) *OpenShiftCollectorClient_APIResources_Call {
+	return &OpenShiftCollectorClient_APIResources_Call{Call: _e.mock.On("APIResources", ctx)}
+}
+
+func (_c *OpenShiftCollectorClient_APIResources_Call) Run(run func(ctx context.Context)) *OpenShiftCollectorClient_APIResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_APIResources_Call) Return(_a0 []*v1.APIResourceList, _a1 error) *OpenShiftCollectorClient_APIResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_APIResources_Call) RunAndReturn(run func(context.Context) ([]*v1.APIResourceList, error)) *OpenShiftCollectorClient_APIResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields: ctx
 func (_m *OpenShiftCollectorClient) Close(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -298,6 +354,49 @@ func (_c *OpenShiftCollectorClient_StreamClusterRoles_Call) RunAndReturn(run fun
 	return _c
 }
 
+// StreamCustomResources provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamCustomResources(ctx context.Context, ingestor collector.CustomResourceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.CustomResourceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamCustomResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamCustomResources'
+type OpenShiftCollectorClient_StreamCustomResources_Call struct {
+	*mock.Call
+}
+
+// StreamCustomResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.CustomResourceIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamCustomResources(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamCustomResources_Call {
+	return &OpenShiftCollectorClient_StreamCustomResources_Call{Call: _e.mock.On("StreamCustomResources", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamCustomResources_Call) Run(run func(ctx context.Context, ingestor collector.CustomResourceIngestor)) *OpenShiftCollectorClient_StreamCustomResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.CustomResourceIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamCustomResources_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamCustomResources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamCustomResources_Call) RunAndReturn(run func(context.Context, collector.CustomResourceIngestor) error) *OpenShiftCollectorClient_StreamCustomResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StreamEndpoints provides a mock function with given fields: ctx, ingestor
 func (_m *OpenShiftCollectorClient) StreamEndpoints(ctx context.Context, ingestor collector.EndpointIngestor) error {
 	ret := _m.Called(ctx, ingestor)
@@ -341,6 +440,178 @@ func (_c *OpenShiftCollectorClient_StreamEndpoints_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// StreamGroups provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamGroups(ctx context.Context, ingestor collector.GroupIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.GroupIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamGroups'
+type OpenShiftCollectorClient_StreamGroups_Call struct {
+	*mock.Call
+}
+
+// StreamGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.GroupIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamGroups(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamGroups_Call {
+	return &OpenShiftCollectorClient_StreamGroups_Call{Call: _e.mock.On("StreamGroups", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamGroups_Call) Run(run func(ctx context.Context, ingestor collector.GroupIngestor)) *OpenShiftCollectorClient_StreamGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.GroupIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamGroups_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamGroups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamGroups_Call) RunAndReturn(run func(context.Context, collector.GroupIngestor) error) *OpenShiftCollectorClient_StreamGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamIngresses provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamIngresses(ctx context.Context, ingestor collector.IngressIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.IngressIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamIngresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamIngresses'
+type OpenShiftCollectorClient_StreamIngresses_Call struct {
+	*mock.Call
+}
+
+// StreamIngresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.IngressIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamIngresses(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamIngresses_Call {
+	return &OpenShiftCollectorClient_StreamIngresses_Call{Call: _e.mock.On("StreamIngresses", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamIngresses_Call) Run(run func(ctx context.Context, ingestor collector.IngressIngestor)) *OpenShiftCollectorClient_StreamIngresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.IngressIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamIngresses_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamIngresses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamIngresses_Call) RunAndReturn(run func(context.Context, collector.IngressIngestor) error) *OpenShiftCollectorClient_StreamIngresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamNamespaces provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamNamespaces(ctx context.Context, ingestor collector.NamespaceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.NamespaceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamNamespaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamNamespaces'
+type OpenShiftCollectorClient_StreamNamespaces_Call struct {
+	*mock.Call
+}
+
+// StreamNamespaces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.NamespaceIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamNamespaces(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamNamespaces_Call {
+	return &OpenShiftCollectorClient_StreamNamespaces_Call{Call: _e.mock.On("StreamNamespaces", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamNamespaces_Call) Run(run func(ctx context.Context, ingestor collector.NamespaceIngestor)) *OpenShiftCollectorClient_StreamNamespaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.NamespaceIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamNamespaces_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamNamespaces_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamNamespaces_Call) RunAndReturn(run func(context.Context, collector.NamespaceIngestor) error) *OpenShiftCollectorClient_StreamNamespaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamNetworkPolicies provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamNetworkPolicies(ctx context.Context, ingestor collector.NetworkPolicyIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.NetworkPolicyIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamNetworkPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamNetworkPolicies'
+type OpenShiftCollectorClient_StreamNetworkPolicies_Call struct {
+	*mock.Call
+}
+
+// StreamNetworkPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.NetworkPolicyIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamNetworkPolicies(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamNetworkPolicies_Call {
+	return &OpenShiftCollectorClient_StreamNetworkPolicies_Call{Call: _e.mock.On("StreamNetworkPolicies", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamNetworkPolicies_Call) Run(run func(ctx context.Context, ingestor collector.NetworkPolicyIngestor)) *OpenShiftCollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.NetworkPolicyIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamNetworkPolicies_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamNetworkPolicies_Call) RunAndReturn(run func(context.Context, collector.NetworkPolicyIngestor) error) *OpenShiftCollectorClient_StreamNetworkPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StreamNodes provides a mock function with given fields: ctx, ingestor
 func (_m *OpenShiftCollectorClient) StreamNodes(ctx context.Context, ingestor collector.NodeIngestor) error {
 	ret := _m.Called(ctx, ingestor)
@@ -556,6 +827,178 @@ func (_c *OpenShiftCollectorClient_StreamRoutes_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// StreamSecrets provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamSecrets(ctx context.Context, ingestor collector.SecretIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.SecretIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSecrets'
+type OpenShiftCollectorClient_StreamSecrets_Call struct {
+	*mock.Call
+}
+
+// StreamSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.SecretIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamSecrets(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamSecrets_Call {
+	return &OpenShiftCollectorClient_StreamSecrets_Call{Call: _e.mock.On("StreamSecrets", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamSecrets_Call) Run(run func(ctx context.Context, ingestor collector.SecretIngestor)) *OpenShiftCollectorClient_StreamSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.SecretIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamSecrets_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamSecrets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamSecrets_Call) RunAndReturn(run func(context.Context, collector.SecretIngestor) error) *OpenShiftCollectorClient_StreamSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamServices provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamServices(ctx context.Context, ingestor collector.ServiceIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.ServiceIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamServices'
+type OpenShiftCollectorClient_StreamServices_Call struct {
+	*mock.Call
+}
+
+// StreamServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.ServiceIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamServices(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamServices_Call {
+	return &OpenShiftCollectorClient_StreamServices_Call{Call: _e.mock.On("StreamServices", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamServices_Call) Run(run func(ctx context.Context, ingestor collector.ServiceIngestor)) *OpenShiftCollectorClient_StreamServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.ServiceIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamServices_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamServices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamServices_Call) RunAndReturn(run func(context.Context, collector.ServiceIngestor) error) *OpenShiftCollectorClient_StreamServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamWebhookConfigurations provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamWebhookConfigurations(ctx context.Context, ingestor collector.WebhookConfigurationIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.WebhookConfigurationIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamWebhookConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamWebhookConfigurations'
+type OpenShiftCollectorClient_StreamWebhookConfigurations_Call struct {
+	*mock.Call
+}
+
+// StreamWebhookConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.WebhookConfigurationIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamWebhookConfigurations(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamWebhookConfigurations_Call {
+	return &OpenShiftCollectorClient_StreamWebhookConfigurations_Call{Call: _e.mock.On("StreamWebhookConfigurations", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamWebhookConfigurations_Call) Run(run func(ctx context.Context, ingestor collector.WebhookConfigurationIngestor)) *OpenShiftCollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.WebhookConfigurationIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamWebhookConfigurations_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamWebhookConfigurations_Call) RunAndReturn(run func(context.Context, collector.WebhookConfigurationIngestor) error) *OpenShiftCollectorClient_StreamWebhookConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamWorkloads provides a mock function with given fields: ctx, ingestor
+func (_m *OpenShiftCollectorClient) StreamWorkloads(ctx context.Context, ingestor collector.WorkloadIngestor) error {
+	ret := _m.Called(ctx, ingestor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, collector.WorkloadIngestor) error); ok {
+		r0 = rf(ctx, ingestor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenShiftCollectorClient_StreamWorkloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamWorkloads'
+type OpenShiftCollectorClient_StreamWorkloads_Call struct {
+	*mock.Call
+}
+
+// StreamWorkloads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingestor collector.WorkloadIngestor
+func (_e *OpenShiftCollectorClient_Expecter) StreamWorkloads(ctx interface{}, ingestor interface{}) *OpenShiftCollectorClient_StreamWorkloads_Call {
+	return &OpenShiftCollectorClient_StreamWorkloads_Call{Call: _e.mock.On("StreamWorkloads", ctx, ingestor)}
+}
+
+func (_c *OpenShiftCollectorClient_StreamWorkloads_Call) Run(run func(ctx context.Context, ingestor collector.WorkloadIngestor)) *OpenShiftCollectorClient_StreamWorkloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(collector.WorkloadIngestor))
+	})
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamWorkloads_Call) Return(_a0 error) *OpenShiftCollectorClient_StreamWorkloads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenShiftCollectorClient_StreamWorkloads_Call) RunAndReturn(run func(context.Context, collector.WorkloadIngestor) error) *OpenShiftCollectorClient_StreamWorkloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewOpenShiftCollectorClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+)
+
+// CustomResourceIngestor is an autogenerated mock type for the CustomResourceIngestor type
+type CustomResourceIngestor struct {
+	mock.Mock
+}
+
+type CustomResourceIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CustomResourceIngestor) EXPECT() *CustomResourceIngestor_Expecter {
+	return &CustomResourceIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *CustomResourceIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomResourceIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type CustomResourceIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *CustomResourceIngestor_Expecter) Complete(_a0 interface{}) *CustomResourceIngestor_Complete_Call {
+	return &CustomResourceIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *CustomResourceIngestor_Complete_Call) Run(run func(_a0 context.Context)) *CustomResourceIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CustomResourceIngestor_Complete_Call) Return(_a0 error) *CustomResourceIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomResourceIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *CustomResourceIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestCustomResource provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CustomResourceIngestor) IngestCustomResource(_a0 context.Context, _a1 schema.GroupVersionResource, _a2 types.CustomResourceType) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, schema.GroupVersionResource, types.CustomResourceType) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomResourceIngestor_IngestCustomResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestCustomResource'
+type CustomResourceIngestor_IngestCustomResource_Call struct {
+	*mock.Call
+}
+
+// IngestCustomResource is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 schema.GroupVersionResource
+//   - _a2 types.CustomResourceType
+func (_e *CustomResourceIngestor_Expecter) IngestCustomResource(_a0 interface{}, _a1 interface{}, _a2 interface{}) *CustomResourceIngestor_IngestCustomResource_Call {
+	return &CustomResourceIngestor_IngestCustomResource_Call{Call: _e.mock.On("IngestCustomResource", _a0, _a1, _a2)}
+}
+
+func (_c *CustomResourceIngestor_IngestCustomResource_Call) Run(run func(_a0 context.Context, _a1 schema.GroupVersionResource, _a2 types.CustomResourceType)) *CustomResourceIngestor_IngestCustomResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schema.GroupVersionResource), args[2].(types.CustomResourceType))
+	})
+	return _c
+}
+
+func (_c *CustomResourceIngestor_IngestCustomResource_Call) Return(_a0 error) *CustomResourceIngestor_IngestCustomResource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomResourceIngestor_IngestCustomResource_Call) RunAndReturn(run func(context.Context, schema.GroupVersionResource, types.CustomResourceType) error) *CustomResourceIngestor_IngestCustomResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewCustomResourceIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCustomResourceIngestor creates a new instance of CustomResourceIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCustomResourceIngestor(t mockConstructorTestingTNewCustomResourceIngestor) *CustomResourceIngestor {
+	mock := &CustomResourceIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GroupIngestor is an autogenerated mock type for the GroupIngestor type
+type GroupIngestor struct {
+	mock.Mock
+}
+
+type GroupIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupIngestor) EXPECT() *GroupIngestor_Expecter {
+	return &GroupIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *GroupIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type GroupIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *GroupIngestor_Expecter) Complete(_a0 interface{}) *GroupIngestor_Complete_Call {
+	return &GroupIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *GroupIngestor_Complete_Call) Run(run func(_a0 context.Context)) *GroupIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupIngestor_Complete_Call) Return(_a0 error) *GroupIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *GroupIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestGroup provides a mock function with given fields: _a0, _a1
+func (_m *GroupIngestor) IngestGroup(_a0 context.Context, _a1 types.GroupType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.GroupType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupIngestor_IngestGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestGroup'
+type GroupIngestor_IngestGroup_Call struct {
+	*mock.Call
+}
+
+// IngestGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.GroupType
+func (_e *GroupIngestor_Expecter) IngestGroup(_a0 interface{}, _a1 interface{}) *GroupIngestor_IngestGroup_Call {
+	return &GroupIngestor_IngestGroup_Call{Call: _e.mock.On("IngestGroup", _a0, _a1)}
+}
+
+func (_c *GroupIngestor_IngestGroup_Call) Run(run func(_a0 context.Context, _a1 types.GroupType)) *GroupIngestor_IngestGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.GroupType))
+	})
+	return _c
+}
+
+func (_c *GroupIngestor_IngestGroup_Call) Return(_a0 error) *GroupIngestor_IngestGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupIngestor_IngestGroup_Call) RunAndReturn(run func(context.Context, types.GroupType) error) *GroupIngestor_IngestGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewGroupIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGroupIngestor creates a new instance of GroupIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGroupIngestor(t mockConstructorTestingTNewGroupIngestor) *GroupIngestor {
+	mock := &GroupIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
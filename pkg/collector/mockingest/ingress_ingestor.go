@@ -0,0 +1,209 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IngressIngestor is an autogenerated mock type for the IngressIngestor type
+type IngressIngestor struct {
+	mock.Mock
+}
+
+type IngressIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IngressIngestor) EXPECT() *IngressIngestor_Expecter {
+	return &IngressIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *IngressIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IngressIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type IngressIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *IngressIngestor_Expecter) Complete(_a0 interface{}) *IngressIngestor_Complete_Call {
+	return &IngressIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *IngressIngestor_Complete_Call) Run(run func(_a0 context.Context)) *IngressIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IngressIngestor_Complete_Call) Return(_a0 error) *IngressIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IngressIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *IngressIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestGateway provides a mock function with given fields: _a0, _a1
+func (_m *IngressIngestor) IngestGateway(_a0 context.Context, _a1 types.GatewayType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.GatewayType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IngressIngestor_IngestGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestGateway'
+type IngressIngestor_IngestGateway_Call struct {
+	*mock.Call
+}
+
+// IngestGateway is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.GatewayType
+func (_e *IngressIngestor_Expecter) IngestGateway(_a0 interface{}, _a1 interface{}) *IngressIngestor_IngestGateway_Call {
+	return &IngressIngestor_IngestGateway_Call{Call: _e.mock.On("IngestGateway", _a0, _a1)}
+}
+
+func (_c *IngressIngestor_IngestGateway_Call) Run(run func(_a0 context.Context, _a1 types.GatewayType)) *IngressIngestor_IngestGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.GatewayType))
+	})
+	return _c
+}
+
+func (_c *IngressIngestor_IngestGateway_Call) Return(_a0 error) *IngressIngestor_IngestGateway_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IngressIngestor_IngestGateway_Call) RunAndReturn(run func(context.Context, types.GatewayType) error) *IngressIngestor_IngestGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestHTTPRoute provides a mock function with given fields: _a0, _a1
+func (_m *IngressIngestor) IngestHTTPRoute(_a0 context.Context, _a1 types.HTTPRouteType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.HTTPRouteType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IngressIngestor_IngestHTTPRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestHTTPRoute'
+type IngressIngestor_IngestHTTPRoute_Call struct {
+	*mock.Call
+}
+
+// IngestHTTPRoute is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.HTTPRouteType
+func (_e *IngressIngestor_Expecter) IngestHTTPRoute(_a0 interface{}, _a1 interface{}) *IngressIngestor_IngestHTTPRoute_Call {
+	return &IngressIngestor_IngestHTTPRoute_Call{Call: _e.mock.On("IngestHTTPRoute", _a0, _a1)}
+}
+
+func (_c *IngressIngestor_IngestHTTPRoute_Call) Run(run func(_a0 context.Context, _a1 types.HTTPRouteType)) *IngressIngestor_IngestHTTPRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.HTTPRouteType))
+	})
+	return _c
+}
+
+func (_c *IngressIngestor_IngestHTTPRoute_Call) Return(_a0 error) *IngressIngestor_IngestHTTPRoute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IngressIngestor_IngestHTTPRoute_Call) RunAndReturn(run func(context.Context, types.HTTPRouteType) error) *IngressIngestor_IngestHTTPRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestIngress provides a mock function with given fields: _a0, _a1
+func (_m *IngressIngestor) IngestIngress(_a0 context.Context, _a1 types.IngressType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.IngressType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IngressIngestor_IngestIngress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestIngress'
+type IngressIngestor_IngestIngress_Call struct {
+	*mock.Call
+}
+
+// IngestIngress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.IngressType
+func (_e *IngressIngestor_Expecter) IngestIngress(_a0 interface{}, _a1 interface{}) *IngressIngestor_IngestIngress_Call {
+	return &IngressIngestor_IngestIngress_Call{Call: _e.mock.On("IngestIngress", _a0, _a1)}
+}
+
+func (_c *IngressIngestor_IngestIngress_Call) Run(run func(_a0 context.Context, _a1 types.IngressType)) *IngressIngestor_IngestIngress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.IngressType))
+	})
+	return _c
+}
+
+func (_c *IngressIngestor_IngestIngress_Call) Return(_a0 error) *IngressIngestor_IngestIngress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IngressIngestor_IngestIngress_Call) RunAndReturn(run func(context.Context, types.IngressType) error) *IngressIngestor_IngestIngress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewIngressIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIngressIngestor creates a new instance of IngressIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIngressIngestor(t mockConstructorTestingTNewIngressIngestor) *IngressIngestor {
+	mock := &IngressIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NamespaceIngestor is an autogenerated mock type for the NamespaceIngestor type
+type NamespaceIngestor struct {
+	mock.Mock
+}
+
+type NamespaceIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NamespaceIngestor) EXPECT() *NamespaceIngestor_Expecter {
+	return &NamespaceIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *NamespaceIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NamespaceIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type NamespaceIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *NamespaceIngestor_Expecter) Complete(_a0 interface{}) *NamespaceIngestor_Complete_Call {
+	return &NamespaceIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *NamespaceIngestor_Complete_Call) Run(run func(_a0 context.Context)) *NamespaceIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NamespaceIngestor_Complete_Call) Return(_a0 error) *NamespaceIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NamespaceIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *NamespaceIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestNamespace provides a mock function with given fields: _a0, _a1
+func (_m *NamespaceIngestor) IngestNamespace(_a0 context.Context, _a1 types.NamespaceType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.NamespaceType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NamespaceIngestor_IngestNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestNamespace'
+type NamespaceIngestor_IngestNamespace_Call struct {
+	*mock.Call
+}
+
+// IngestNamespace is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.NamespaceType
+func (_e *NamespaceIngestor_Expecter) IngestNamespace(_a0 interface{}, _a1 interface{}) *NamespaceIngestor_IngestNamespace_Call {
+	return &NamespaceIngestor_IngestNamespace_Call{Call: _e.mock.On("IngestNamespace", _a0, _a1)}
+}
+
+func (_c *NamespaceIngestor_IngestNamespace_Call) Run(run func(_a0 context.Context, _a1 types.NamespaceType)) *NamespaceIngestor_IngestNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.NamespaceType))
+	})
+	return _c
+}
+
+func (_c *NamespaceIngestor_IngestNamespace_Call) Return(_a0 error) *NamespaceIngestor_IngestNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NamespaceIngestor_IngestNamespace_Call) RunAndReturn(run func(context.Context, types.NamespaceType) error) *NamespaceIngestor_IngestNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewNamespaceIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNamespaceIngestor creates a new instance of NamespaceIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNamespaceIngestor(t mockConstructorTestingTNewNamespaceIngestor) *NamespaceIngestor {
+	mock := &NamespaceIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NetworkPolicyIngestor is an autogenerated mock type for the NetworkPolicyIngestor type
+type NetworkPolicyIngestor struct {
+	mock.Mock
+}
+
+type NetworkPolicyIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkPolicyIngestor) EXPECT() *NetworkPolicyIngestor_Expecter {
+	return &NetworkPolicyIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *NetworkPolicyIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkPolicyIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type NetworkPolicyIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *NetworkPolicyIngestor_Expecter) Complete(_a0 interface{}) *NetworkPolicyIngestor_Complete_Call {
+	return &NetworkPolicyIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *NetworkPolicyIngestor_Complete_Call) Run(run func(_a0 context.Context)) *NetworkPolicyIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkPolicyIngestor_Complete_Call) Return(_a0 error) *NetworkPolicyIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkPolicyIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *NetworkPolicyIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestNetworkPolicy provides a mock function with given fields: _a0, _a1
+func (_m *NetworkPolicyIngestor) IngestNetworkPolicy(_a0 context.Context, _a1 types.NetworkPolicyType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.NetworkPolicyType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkPolicyIngestor_IngestNetworkPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestNetworkPolicy'
+type NetworkPolicyIngestor_IngestNetworkPolicy_Call struct {
+	*mock.Call
+}
+
+// IngestNetworkPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.NetworkPolicyType
+func (_e *NetworkPolicyIngestor_Expecter) IngestNetworkPolicy(_a0 interface{}, _a1 interface{}) *NetworkPolicyIngestor_IngestNetworkPolicy_Call {
+	return &NetworkPolicyIngestor_IngestNetworkPolicy_Call{Call: _e.mock.On("IngestNetworkPolicy", _a0, _a1)}
+}
+
+func (_c *NetworkPolicyIngestor_IngestNetworkPolicy_Call) Run(run func(_a0 context.Context, _a1 types.NetworkPolicyType)) *NetworkPolicyIngestor_IngestNetworkPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.NetworkPolicyType))
+	})
+	return _c
+}
+
+func (_c *NetworkPolicyIngestor_IngestNetworkPolicy_Call) Return(_a0 error) *NetworkPolicyIngestor_IngestNetworkPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkPolicyIngestor_IngestNetworkPolicy_Call) RunAndReturn(run func(context.Context, types.NetworkPolicyType) error) *NetworkPolicyIngestor_IngestNetworkPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewNetworkPolicyIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNetworkPolicyIngestor creates a new instance of NetworkPolicyIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNetworkPolicyIngestor(t mockConstructorTestingTNewNetworkPolicyIngestor) *NetworkPolicyIngestor {
+	mock := &NetworkPolicyIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
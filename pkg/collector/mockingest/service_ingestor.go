@@ -0,0 +1,123 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceIngestor is an autogenerated mock type for the ServiceIngestor type
+type ServiceIngestor struct {
+	mock.Mock
+}
+
+type ServiceIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceIngestor) EXPECT() *ServiceIngestor_Expecter {
+	return &ServiceIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *ServiceIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type ServiceIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *ServiceIngestor_Expecter) Complete(_a0 interface{}) *ServiceIngestor_Complete_Call {
+	return &ServiceIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *ServiceIngestor_Complete_Call) Run(run func(_a0 context.Context)) *ServiceIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ServiceIngestor_Complete_Call) Return(_a0 error) *ServiceIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *ServiceIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestService provides a mock function with given fields: _a0, _a1
+func (_m *ServiceIngestor) IngestService(_a0 context.Context, _a1 types.ServiceType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.ServiceType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceIngestor_IngestService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestService'
+type ServiceIngestor_IngestService_Call struct {
+	*mock.Call
+}
+
+// IngestService is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.ServiceType
+func (_e *ServiceIngestor_Expecter) IngestService(_a0 interface{}, _a1 interface{}) *ServiceIngestor_IngestService_Call {
+	return &ServiceIngestor_IngestService_Call{Call: _e.mock.On("IngestService", _a0, _a1)}
+}
+
+func (_c *ServiceIngestor_IngestService_Call) Run(run func(_a0 context.Context, _a1 types.ServiceType)) *ServiceIngestor_IngestService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.ServiceType))
+	})
+	return _c
+}
+
+func (_c *ServiceIngestor_IngestService_Call) Return(_a0 error) *ServiceIngestor_IngestService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceIngestor_IngestService_Call) RunAndReturn(run func(context.Context, types.ServiceType) error) *ServiceIngestor_IngestService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewServiceIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewServiceIngestor creates a new instance of ServiceIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewServiceIngestor(t mockConstructorTestingTNewServiceIngestor) *ServiceIngestor {
+	mock := &ServiceIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
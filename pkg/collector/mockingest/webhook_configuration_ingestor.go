@@ -0,0 +1,166 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookConfigurationIngestor is an autogenerated mock type for the WebhookConfigurationIngestor type
+type WebhookConfigurationIngestor struct {
+	mock.Mock
+}
+
+type WebhookConfigurationIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookConfigurationIngestor) EXPECT() *WebhookConfigurationIngestor_Expecter {
+	return &WebhookConfigurationIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *WebhookConfigurationIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookConfigurationIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type WebhookConfigurationIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *WebhookConfigurationIngestor_Expecter) Complete(_a0 interface{}) *WebhookConfigurationIngestor_Complete_Call {
+	return &WebhookConfigurationIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *WebhookConfigurationIngestor_Complete_Call) Run(run func(_a0 context.Context)) *WebhookConfigurationIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_Complete_Call) Return(_a0 error) *WebhookConfigurationIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *WebhookConfigurationIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestMutatingWebhookConfiguration provides a mock function with given fields: _a0, _a1
+func (_m *WebhookConfigurationIngestor) IngestMutatingWebhookConfiguration(_a0 context.Context, _a1 types.MutatingWebhookConfigurationType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.MutatingWebhookConfigurationType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestMutatingWebhookConfiguration'
+type WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call struct {
+	*mock.Call
+}
+
+// IngestMutatingWebhookConfiguration is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.MutatingWebhookConfigurationType
+func (_e *WebhookConfigurationIngestor_Expecter) IngestMutatingWebhookConfiguration(_a0 interface{}, _a1 interface{}) *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call {
+	return &WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call{Call: _e.mock.On("IngestMutatingWebhookConfiguration", _a0, _a1)}
+}
+
+func (_c *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call) Run(run func(_a0 context.Context, _a1 types.MutatingWebhookConfigurationType)) *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.MutatingWebhookConfigurationType))
+	})
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call) Return(_a0 error) *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call) RunAndReturn(run func(context.Context, types.MutatingWebhookConfigurationType) error) *WebhookConfigurationIngestor_IngestMutatingWebhookConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestValidatingWebhookConfiguration provides a mock function with given fields: _a0, _a1
+func (_m *WebhookConfigurationIngestor) IngestValidatingWebhookConfiguration(_a0 context.Context, _a1 types.ValidatingWebhookConfigurationType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.ValidatingWebhookConfigurationType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestValidatingWebhookConfiguration'
+type WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call struct {
+	*mock.Call
+}
+
+// IngestValidatingWebhookConfiguration is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.ValidatingWebhookConfigurationType
+func (_e *WebhookConfigurationIngestor_Expecter) IngestValidatingWebhookConfiguration(_a0 interface{}, _a1 interface{}) *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call {
+	return &WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call{Call: _e.mock.On("IngestValidatingWebhookConfiguration", _a0, _a1)}
+}
+
+func (_c *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call) Run(run func(_a0 context.Context, _a1 types.ValidatingWebhookConfigurationType)) *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.ValidatingWebhookConfigurationType))
+	})
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call) Return(_a0 error) *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call) RunAndReturn(run func(context.Context, types.ValidatingWebhookConfigurationType) error) *WebhookConfigurationIngestor_IngestValidatingWebhookConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewWebhookConfigurationIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWebhookConfigurationIngestor creates a new instance of WebhookConfigurationIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWebhookConfigurationIngestor(t mockConstructorTestingTNewWebhookConfigurationIngestor) *WebhookConfigurationIngestor {
+	mock := &WebhookConfigurationIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
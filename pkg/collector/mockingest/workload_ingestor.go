@@ -0,0 +1,338 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	types "github.com/DataDog/KubeHound/pkg/globals/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WorkloadIngestor is an autogenerated mock type for the WorkloadIngestor type
+type WorkloadIngestor struct {
+	mock.Mock
+}
+
+type WorkloadIngestor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WorkloadIngestor) EXPECT() *WorkloadIngestor_Expecter {
+	return &WorkloadIngestor_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: _a0
+func (_m *WorkloadIngestor) Complete(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type WorkloadIngestor_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *WorkloadIngestor_Expecter) Complete(_a0 interface{}) *WorkloadIngestor_Complete_Call {
+	return &WorkloadIngestor_Complete_Call{Call: _e.mock.On("Complete", _a0)}
+}
+
+func (_c *WorkloadIngestor_Complete_Call) Run(run func(_a0 context.Context)) *WorkloadIngestor_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_Complete_Call) Return(_a0 error) *WorkloadIngestor_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_Complete_Call) RunAndReturn(run func(context.Context) error) *WorkloadIngestor_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestCronJob provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestCronJob(_a0 context.Context, _a1 types.CronJobType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CronJobType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestCronJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestCronJob'
+type WorkloadIngestor_IngestCronJob_Call struct {
+	*mock.Call
+}
+
+// IngestCronJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.CronJobType
+func (_e *WorkloadIngestor_Expecter) IngestCronJob(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestCronJob_Call {
+	return &WorkloadIngestor_IngestCronJob_Call{Call: _e.mock.On("IngestCronJob", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestCronJob_Call) Run(run func(_a0 context.Context, _a1 types.CronJobType)) *WorkloadIngestor_IngestCronJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CronJobType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestCronJob_Call) Return(_a0 error) *WorkloadIngestor_IngestCronJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestCronJob_Call) RunAndReturn(run func(context.Context, types.CronJobType) error) *WorkloadIngestor_IngestCronJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestDaemonSet provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestDaemonSet(_a0 context.Context, _a1 types.DaemonSetType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.DaemonSetType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestDaemonSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestDaemonSet'
+type WorkloadIngestor_IngestDaemonSet_Call struct {
+	*mock.Call
+}
+
+// IngestDaemonSet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.DaemonSetType
+func (_e *WorkloadIngestor_Expecter) IngestDaemonSet(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestDaemonSet_Call {
+	return &WorkloadIngestor_IngestDaemonSet_Call{Call: _e.mock.On("IngestDaemonSet", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestDaemonSet_Call) Run(run func(_a0 context.Context, _a1 types.DaemonSetType)) *WorkloadIngestor_IngestDaemonSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.DaemonSetType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestDaemonSet_Call) Return(_a0 error) *WorkloadIngestor_IngestDaemonSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestDaemonSet_Call) RunAndReturn(run func(context.Context, types.DaemonSetType) error) *WorkloadIngestor_IngestDaemonSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestDeployment provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestDeployment(_a0 context.Context, _a1 types.DeploymentType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.DeploymentType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestDeployment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestDeployment'
+type WorkloadIngestor_IngestDeployment_Call struct {
+	*mock.Call
+}
+
+// IngestDeployment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.DeploymentType
+func (_e *WorkloadIngestor_Expecter) IngestDeployment(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestDeployment_Call {
+	return &WorkloadIngestor_IngestDeployment_Call{Call: _e.mock.On("IngestDeployment", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestDeployment_Call) Run(run func(_a0 context.Context, _a1 types.DeploymentType)) *WorkloadIngestor_IngestDeployment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.DeploymentType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestDeployment_Call) Return(_a0 error) *WorkloadIngestor_IngestDeployment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestDeployment_Call) RunAndReturn(run func(context.Context, types.DeploymentType) error) *WorkloadIngestor_IngestDeployment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestJob provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestJob(_a0 context.Context, _a1 types.JobType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.JobType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestJob'
+type WorkloadIngestor_IngestJob_Call struct {
+	*mock.Call
+}
+
+// IngestJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.JobType
+func (_e *WorkloadIngestor_Expecter) IngestJob(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestJob_Call {
+	return &WorkloadIngestor_IngestJob_Call{Call: _e.mock.On("IngestJob", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestJob_Call) Run(run func(_a0 context.Context, _a1 types.JobType)) *WorkloadIngestor_IngestJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.JobType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestJob_Call) Return(_a0 error) *WorkloadIngestor_IngestJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestJob_Call) RunAndReturn(run func(context.Context, types.JobType) error) *WorkloadIngestor_IngestJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestReplicaSet provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestReplicaSet(_a0 context.Context, _a1 types.ReplicaSetType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.ReplicaSetType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestReplicaSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestReplicaSet'
+type WorkloadIngestor_IngestReplicaSet_Call struct {
+	*mock.Call
+}
+
+// IngestReplicaSet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.ReplicaSetType
+func (_e *WorkloadIngestor_Expecter) IngestReplicaSet(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestReplicaSet_Call {
+	return &WorkloadIngestor_IngestReplicaSet_Call{Call: _e.mock.On("IngestReplicaSet", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestReplicaSet_Call) Run(run func(_a0 context.Context, _a1 types.ReplicaSetType)) *WorkloadIngestor_IngestReplicaSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.ReplicaSetType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestReplicaSet_Call) Return(_a0 error) *WorkloadIngestor_IngestReplicaSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestReplicaSet_Call) RunAndReturn(run func(context.Context, types.ReplicaSetType) error) *WorkloadIngestor_IngestReplicaSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestStatefulSet provides a mock function with given fields: _a0, _a1
+func (_m *WorkloadIngestor) IngestStatefulSet(_a0 context.Context, _a1 types.StatefulSetType) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.StatefulSetType) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkloadIngestor_IngestStatefulSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestStatefulSet'
+type WorkloadIngestor_IngestStatefulSet_Call struct {
+	*mock.Call
+}
+
+// IngestStatefulSet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 types.StatefulSetType
+func (_e *WorkloadIngestor_Expecter) IngestStatefulSet(_a0 interface{}, _a1 interface{}) *WorkloadIngestor_IngestStatefulSet_Call {
+	return &WorkloadIngestor_IngestStatefulSet_Call{Call: _e.mock.On("IngestStatefulSet", _a0, _a1)}
+}
+
+func (_c *WorkloadIngestor_IngestStatefulSet_Call) Run(run func(_a0 context.Context, _a1 types.StatefulSetType)) *WorkloadIngestor_IngestStatefulSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.StatefulSetType))
+	})
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestStatefulSet_Call) Return(_a0 error) *WorkloadIngestor_IngestStatefulSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkloadIngestor_IngestStatefulSet_Call) RunAndReturn(run func(context.Context, types.StatefulSetType) error) *WorkloadIngestor_IngestStatefulSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewWorkloadIngestor interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWorkloadIngestor creates a new instance of WorkloadIngestor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWorkloadIngestor(t mockConstructorTestingTNewWorkloadIngestor) *WorkloadIngestor {
+	mock := &WorkloadIngestor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
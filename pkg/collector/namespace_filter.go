@@ -0,0 +1,97 @@
+package collector
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+)
+
+// namespaceMatcher matches a namespace name against a single configured pattern.
+type namespaceMatcher func(namespace string) bool
+
+// namespaceFilter restricts the namespaces processed by a collector. A nil filter allows all namespaces.
+type namespaceFilter struct {
+	include []namespaceMatcher
+	exclude []namespaceMatcher
+}
+
+// newNamespaceFilter compiles the namespace filter patterns from the provided collector configuration.
+// Returns a nil filter if no filtering is configured.
+func newNamespaceFilter(cfg *config.NamespaceFilterConfig) (*namespaceFilter, error) {
+	if cfg == nil || (len(cfg.Include) == 0 && len(cfg.Exclude) == 0) {
+		return nil, nil //nolint:nilnil
+	}
+
+	include, err := compileNamespacePatterns(cfg.Include)
+	if err != nil {
+		return nil, fmt.Errorf("namespace include filter: %w", err)
+	}
+
+	exclude, err := compileNamespacePatterns(cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("namespace exclude filter: %w", err)
+	}
+
+	return &namespaceFilter{
+		include: include,
+		exclude: exclude,
+	}, nil
+}
+
+// compileNamespacePatterns compiles a list of glob or regex (enclosed in slashes) patterns into matchers.
+func compileNamespacePatterns(patterns []string) ([]namespaceMatcher, error) {
+	matchers := make([]namespaceMatcher, 0, len(patterns))
+	for _, p := range patterns {
+		if len(p) > 1 && strings.HasPrefix(p, "/") && strings.HasSuffix(p, "/") {
+			re, err := regexp.Compile(p[1 : len(p)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex pattern %s: %w", p, err)
+			}
+
+			matchers = append(matchers, re.MatchString)
+
+			continue
+		}
+
+		// Validate the glob pattern upfront as path.Match only reports errors on use
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %s: %w", p, err)
+		}
+
+		glob := p
+		matchers = append(matchers, func(namespace string) bool {
+			ok, _ := path.Match(glob, namespace)
+
+			return ok
+		})
+	}
+
+	return matchers, nil
+}
+
+// Allowed returns whether objects from the provided namespace should be collected.
+// Cluster scoped objects (empty namespace) are always allowed.
+func (f *namespaceFilter) Allowed(namespace string) bool {
+	if f == nil || namespace == "" {
+		return true
+	}
+
+	if len(f.include) > 0 && !anyNamespaceMatch(f.include, namespace) {
+		return false
+	}
+
+	return !anyNamespaceMatch(f.exclude, namespace)
+}
+
+func anyNamespaceMatch(matchers []namespaceMatcher, namespace string) bool {
+	for _, m := range matchers {
+		if m(namespace) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceFilter_Allowed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     *config.NamespaceFilterConfig
+		allowed map[string]bool
+	}{
+		{
+			name: "no filter",
+			cfg:  nil,
+			allowed: map[string]bool{
+				"default":     true,
+				"kube-system": true,
+			},
+		},
+		{
+			name: "glob include",
+			cfg: &config.NamespaceFilterConfig{
+				Include: []string{"team-*"},
+			},
+			allowed: map[string]bool{
+				"team-a":  true,
+				"default": false,
+				"":        true,
+			},
+		},
+		{
+			name: "regex exclude",
+			cfg: &config.NamespaceFilterConfig{
+				Exclude: []string{"/^kube-.*$/"},
+			},
+			allowed: map[string]bool{
+				"kube-system":  false,
+				"kube-public":  false,
+				"default":      true,
+				"my-kube-apps": true,
+			},
+		},
+		{
+			name: "exclude applied after include",
+			cfg: &config.NamespaceFilterConfig{
+				Include: []string{"team-*", "default"},
+				Exclude: []string{"team-b"},
+			},
+			allowed: map[string]bool{
+				"team-a":      true,
+				"team-b":      false,
+				"default":     true,
+				"kube-system": false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := newNamespaceFilter(tt.cfg)
+			assert.NoError(t, err)
+
+			for ns, want := range tt.allowed {
+				assert.Equal(t, want, f.Allowed(ns), "namespace %q", ns)
+			}
+		})
+	}
+}
+
+func TestNamespaceFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := newNamespaceFilter(&config.NamespaceFilterConfig{
+		Include: []string{"/team-(/"},
+	})
+	assert.ErrorContains(t, err, "invalid regex pattern")
+
+	_, err = newNamespaceFilter(&config.NamespaceFilterConfig{
+		Exclude: []string{"team-["},
+	})
+	assert.ErrorContains(t, err, "invalid glob pattern")
+}
@@ -13,17 +13,20 @@ import (
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/pager"
-	ctrl "sigs.k8s.io/controller-runtime"
 
 	routev1 "github.com/openshift/api/route/v1"
+	userv1 "github.com/openshift/api/user/v1"
 	routev1Clientset "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	userv1Clientset "github.com/openshift/client-go/user/clientset/versioned/typed/user/v1"
 )
 
 type openShiftAPICollector struct {
 	*k8sAPICollector
 	routeClientset routev1Clientset.RouteV1Client
+	userClientset  userv1Clientset.UserV1Client
 }
 
 const (
@@ -49,27 +52,55 @@ func NewOpenShiftAPICollector(ctx context.Context, cfg *config.KubehoundConfig)
 		return nil, err
 	}
 
-	kubeConfig, err := ctrl.GetConfig()
+	liveCfg := cfg.Collector.OpenShiftLive()
+	err = checkPreflightMode(liveCfg.Preflight)
 	if err != nil {
-		return nil, fmt.Errorf("building kubernetes config: %w", err)
+		return nil, err
+	}
+
+	err = checkCustomResourceConfig(cfg.Collector.CustomResources)
+	if err != nil {
+		return nil, err
 	}
 
-	kubeConfig.UserAgent = CollectorUserAgent
+	kubeConfig, err := loadKubeClientConfig(liveCfg)
+	if err != nil {
+		return nil, err
+	}
 
-	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	clientset, err := kubernetes.NewForConfig(kubeConfig.rest)
 	if err != nil {
 		return nil, fmt.Errorf("getting kubernetes config: %w", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig.rest)
+	if err != nil {
+		return nil, fmt.Errorf("getting kubernetes dynamic client: %w", err)
+	}
+
+	nsFilter, err := newNamespaceFilter(cfg.Collector.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Creating OpenShift API collector for cluster %s", kubeConfig.clusterName)
+
 	return &openShiftAPICollector{
 		k8sAPICollector: &k8sAPICollector{
-			cfg:       cfg.Collector.Live,
-			clientset: clientset,
-			log:       l,
-			rl:        ratelimit.New(cfg.Collector.Live.RateLimitPerSecond), // per second
-			tags:      tags,
+			cfg:         liveCfg,
+			clientset:   clientset,
+			dynamic:     dynamicClient,
+			log:         l,
+			rl:          ratelimit.New(liveCfg.RateLimitPerSecond), // per second
+			tags:        tags,
+			nsFilter:    nsFilter,
+			clusterName: kubeConfig.clusterName,
+			resources: append(append(append([]resourceAccess{}, k8sResourceAccess...), openShiftResourceAccess...),
+				customResourceAccess(cfg.Collector.CustomResources)...),
+			customResources: cfg.Collector.CustomResources,
 		},
-		routeClientset: *routev1Clientset.NewForConfigOrDie(kubeConfig),
+		routeClientset: *routev1Clientset.NewForConfigOrDie(kubeConfig.rest),
+		userClientset:  *userv1Clientset.NewForConfigOrDie(kubeConfig.rest),
 	}, nil
 }
 
@@ -104,6 +135,10 @@ func (c *openShiftAPICollector) streamRoutesNamespace(ctx context.Context, names
 			return fmt.Errorf("endpoint stream type conversion error: %T", obj)
 		}
 
+		if !c.nsFilter.Allowed(item.Namespace) {
+			return nil
+		}
+
 		err := ingestor.IngestRoute(ctx, item)
 		if err != nil {
 			return fmt.Errorf("processing K8s endpoint slice %s for namespace %s: %w", item.Name, namespace, err)
@@ -118,6 +153,10 @@ func (c *openShiftAPICollector) StreamRoutes(ctx context.Context, ingestor Route
 	span.SetTag(tag.EntityTag, tag.EntityRoutes)
 	defer span.Finish()
 
+	if c.accessDenied(tag.EntityRoutes) {
+		return ingestor.Complete(ctx)
+	}
+
 	// passing an empty namespace will collect all namespaces
 	err := c.streamRoutesNamespace(ctx, "", ingestor)
 	if err != nil {
@@ -126,3 +165,22 @@ func (c *openShiftAPICollector) StreamRoutes(ctx context.Context, ingestor Route
 
 	return ingestor.Complete(ctx)
 }
+
+func (c *openShiftAPICollector) StreamGroups(ctx context.Context, ingestor GroupIngestor) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.CollectorStream, tracer.Measured())
+	span.SetTag(tag.EntityTag, tag.EntityGroups)
+	defer span.Finish()
+
+	err := streamKind(ctx, c.k8sAPICollector, tag.EntityGroups,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return c.userClientset.Groups().List(ctx, opts)
+		},
+		func(ctx context.Context, item *userv1.Group) error {
+			return ingestor.IngestGroup(ctx, item)
+		})
+	if err != nil {
+		return err
+	}
+
+	return ingestor.Complete(ctx)
+}
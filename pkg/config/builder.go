@@ -13,6 +13,17 @@ const (
 	DefaultStopOnError = false
 )
 
+// DefaultRuntimeSockets lists the host paths of the container runtime sockets granting control over all the containers
+// of a node when mounted into a container. The paths are canonical, /var/run being a symlink to /run.
+var DefaultRuntimeSockets = []string{
+	"/run/containerd/containerd.sock",
+	"/run/docker.sock",
+	"/run/dockershim.sock",
+	"/run/cri-dockerd.sock",
+	"/run/crio/crio.sock",
+	"/run/podman/podman.sock",
+}
+
 // VertexBuilderConfig configures vertex builder parameters.
 type VertexBuilderConfig struct {
 	BatchSize      int `mapstructure:"batch_size"`       // Batch size for inserts
@@ -28,6 +39,8 @@ type EdgeBuilderConfig struct {
 	BatchSize                 int  `mapstructure:"batch_size"`                // Batch size for inserts
 	BatchSizeSmall            int  `mapstructure:"batch_size_small"`          // Batch size for expensive inserts
 	BatchSizeClusterImpact    int  `mapstructure:"batch_size_cluster_impact"` // Batch size for inserts impacting entire cluster e.g POD_PATCH
+
+	RuntimeSockets []string `mapstructure:"runtime_sockets"` // Host paths of the container runtime sockets e.g /run/containerd/containerd.sock
 }
 
 type BuilderConfig struct {
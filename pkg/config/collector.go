@@ -1,5 +1,10 @@
 package config
 
+import (
+	"path/filepath"
+	"strings"
+)
+
 const (
 	CollectorTypeFile          = "file-collector"
 	CollectorTypeK8sAPI        = "live-k8s-api-collector"
@@ -7,6 +12,13 @@ const (
 	CollectorTypeOpenShiftFile = "file-openshift-collector"
 )
 
+const (
+	// Live collector permission preflight modes, applied when the collector identity is missing list permissions on the
+	// required resources (optional resources with missing permissions are always skipped)
+	PreflightModeFail = "fail" // Abort the collection before streaming (default)
+	PreflightModeSkip = "skip" // Skip the collection of the entity types with missing permissions
+)
+
 const (
 	DefaultK8sAPIPageSize           int64 = 500
 	DefaultK8sAPIPageBufferSize     int32 = 10
@@ -15,28 +27,190 @@ const (
 
 // CollectorConfig configures collector specific parameters.
 type CollectorConfig struct {
-	Type          string                       `mapstructure:"type"`           // Collector type
-	File          *FileCollectorConfig         `mapstructure:"file"`           // File collector specific configuration
-	Live          *K8SAPICollectorConfig       `mapstructure:"live"`           // K8S collector specific configuration
-	LiveOpenShift *OpenShiftAPICollectorConfig `mapstructure:"live-openshift"` // OpenShift collector specific configuration
+	Type            string                       `mapstructure:"type"`             // Collector type
+	File            *FileCollectorConfig         `mapstructure:"file"`             // File collector specific configuration
+	Live            *K8SAPICollectorConfig       `mapstructure:"live"`             // K8S collector specific configuration
+	LiveOpenShift   *OpenShiftAPICollectorConfig `mapstructure:"live-openshift"`   // OpenShift collector specific configuration
+	Namespaces      *NamespaceFilterConfig       `mapstructure:"namespaces"`       // Namespace filtering applied by all collectors
+	Clusters        []ClusterConfig              `mapstructure:"clusters"`         // Clusters processed in a single run (defaults to the single configured cluster)
+	CustomResources []CustomResourceConfig       `mapstructure:"custom_resources"` // Custom resources collected through the dynamic client
+}
+
+// CustomResourceConfig identifies a custom resource (e.g Argo CD applications) collected in addition to the built-in
+// K8s entity types. The objects are stored as is in a generic collection, queryable by the edge builders.
+type CustomResourceConfig struct {
+	Group    string `mapstructure:"group"`    // API group of the resource (e.g argoproj.io)
+	Version  string `mapstructure:"version"`  // API version of the resource (e.g v1alpha1)
+	Resource string `mapstructure:"resource"` // Plural resource name as used in RBAC rules (e.g applications)
+	Kind     string `mapstructure:"kind"`     // Object kind (e.g Application), required to read mixed kind list files
+}
+
+// String returns the resource name qualified by its API group.
+func (c CustomResourceConfig) String() string {
+	if c.Group == "" {
+		return c.Resource
+	}
+
+	return c.Resource + "." + c.Group
+}
+
+// ClusterConfig selects a single cluster of a multi-cluster run. Live collectors use the kubeconfig context, while
+// file collectors use the dump directory. Unset values fall back to the collector specific configuration.
+type ClusterConfig struct {
+	Name       string `mapstructure:"name"`       // Reported cluster name (defaults to the context or the directory base name)
+	Context    string `mapstructure:"context"`    // Kubeconfig context of the cluster (live collectors)
+	Kubeconfig string `mapstructure:"kubeconfig"` // Kubeconfig file holding the context (live collectors)
+	Directory  string `mapstructure:"directory"`  // Dump directory (or archive) of the cluster (file collectors)
+}
+
+// IsOpenShift reports whether the configured collector targets an OpenShift cluster.
+func (c *CollectorConfig) IsOpenShift() bool {
+	return c.Type == CollectorTypeOpenShiftAPI || c.Type == CollectorTypeOpenShiftFile
+}
+
+// ForCluster returns a copy of the collector configuration targeting the provided cluster of a multi-cluster run.
+// The collector specific configurations are copied so the receiver is left untouched.
+func (c *CollectorConfig) ForCluster(cluster ClusterConfig) CollectorConfig {
+	cc := *c
+	cc.Clusters = nil
+
+	if c.Live != nil {
+		live := *c.Live
+		cc.Live = &live
+	}
+
+	if c.LiveOpenShift != nil {
+		ocp := *c.LiveOpenShift
+		cc.LiveOpenShift = &ocp
+	}
+
+	if c.File != nil {
+		file := *c.File
+		cc.File = &file
+	}
+
+	for _, live := range []*K8SAPICollectorConfig{cc.Live, liveOpenShiftConfig(cc.LiveOpenShift)} {
+		if live == nil {
+			continue
+		}
+
+		if cluster.Kubeconfig != "" {
+			live.Kubeconfig = cluster.Kubeconfig
+		}
+
+		if cluster.Context != "" {
+			live.Context = cluster.Context
+			// The cluster name would otherwise be shared by all the contexts
+			live.ClusterName = ""
+		}
+
+		if cluster.Name != "" {
+			live.ClusterName = cluster.Name
+		}
+	}
+
+	if cluster.Directory != "" {
+		if cc.File == nil {
+			cc.File = &FileCollectorConfig{}
+		}
+
+		cc.File.Directory = cluster.Directory
+		cc.File.Files = nil
+		// The cluster name would otherwise be shared by all the directories
+		cc.File.ClusterName = dumpClusterName(cluster.Directory)
+	}
+
+	if cc.File != nil && cluster.Name != "" {
+		cc.File.ClusterName = cluster.Name
+	}
+
+	return cc
+}
+
+// dumpClusterName derives a cluster name from the base name of a dump directory or archive.
+func dumpClusterName(directory string) string {
+	name := filepath.Base(filepath.Clean(directory))
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+
+	return name
+}
+
+// liveOpenShiftConfig returns the K8s live collector configuration embedded in the OpenShift one, if provided.
+func liveOpenShiftConfig(c *OpenShiftAPICollectorConfig) *K8SAPICollectorConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &c.K8SAPICollectorConfig
+}
+
+// NamespaceFilterConfig restricts the namespaces processed by the collectors.
+// Patterns are shell globs (e.g kube-*) or regular expressions when enclosed in slashes (e.g /^team-[a-z]+$/).
+// Exclusions are applied after inclusions and cluster-scoped objects are never filtered.
+type NamespaceFilterConfig struct {
+	Include []string `mapstructure:"include"` // Namespaces to collect (all namespaces if empty)
+	Exclude []string `mapstructure:"exclude"` // Namespaces to skip
 }
 
 // K8SAPICollectorConfig configures the K8sAPI collector.
 type K8SAPICollectorConfig struct {
-	PageSize           int64 `mapstructure:"page_size"`             // Number of entry being retrieving by each call on the API (same for all Kubernetes entry types)
-	PageBufferSize     int32 `mapstructure:"page_buffer_size"`      // Number of pages to buffer
-	RateLimitPerSecond int   `mapstructure:"rate_limit_per_second"` // Rate limiting per second across all calls (same for all kubernetes entry types) against the Kubernetes API
+	PageSize           int64  `mapstructure:"page_size"`             // Number of entry being retrieving by each call on the API (same for all Kubernetes entry types)
+	PageBufferSize     int32  `mapstructure:"page_buffer_size"`      // Number of pages to buffer
+	RateLimitPerSecond int    `mapstructure:"rate_limit_per_second"` // Rate limiting per second across all calls (same for all kubernetes entry types) against the Kubernetes API
+	Kubeconfig         string `mapstructure:"kubeconfig"`            // Path to the kubeconfig file (defaults to the standard kubeconfig loading rules)
+	Context            string `mapstructure:"context"`               // Kubeconfig context to collect from (defaults to the current context)
+	InCluster          bool   `mapstructure:"in_cluster"`            // Use the service account of the pod KubeHound runs in, instead of a kubeconfig
+	ClusterName        string `mapstructure:"cluster"`               // Reported cluster name (defaults to the kubeconfig context name)
+	Preflight          string `mapstructure:"preflight"`             // Behaviour on missing required list permissions: fail (default) or skip
 }
 
 // FileCollectorConfig configures the file collector.
 type FileCollectorConfig struct {
-	ClusterName string `mapstructure:"cluster"`   // Target cluster (must be specified in config as not present in JSON files)
-	Directory   string `mapstructure:"directory"` // Base directory holding the K8s data JSON files
+	ClusterName string   `mapstructure:"cluster"`   // Target cluster (must be specified in config as not present in JSON files)
+	Directory   string   `mapstructure:"directory"` // Base directory (or .tar, .tar.gz, .zip archive) holding the K8s data JSON files
+	Files       []string `mapstructure:"files"`     // Mixed kind K8s List files (JSON or multi-document YAML) used instead of the base directory
 }
 
 // OpenShiftAPICollectorConfig builds upon K8SAPICollectorConfig
 type OpenShiftAPICollectorConfig struct {
-	K8SAPICollectorConfig
+	K8SAPICollectorConfig `mapstructure:",squash"`
+}
+
+// OpenShiftLive returns the configuration of the OpenShift live collector. It builds upon the K8s live collector
+// configuration, with the connection settings (kubeconfig, context, in-cluster and cluster name) and the preflight mode
+// overridden by the OpenShift specific configuration if provided.
+func (c *CollectorConfig) OpenShiftLive() *K8SAPICollectorConfig {
+	live := K8SAPICollectorConfig{}
+	if c.Live != nil {
+		live = *c.Live
+	}
+
+	if c.LiveOpenShift == nil {
+		return &live
+	}
+
+	ocp := c.LiveOpenShift.K8SAPICollectorConfig
+	if ocp.Kubeconfig != "" {
+		live.Kubeconfig = ocp.Kubeconfig
+	}
+
+	if ocp.Context != "" {
+		live.Context = ocp.Context
+	}
+
+	if ocp.ClusterName != "" {
+		live.ClusterName = ocp.ClusterName
+	}
+
+	if ocp.Preflight != "" {
+		live.Preflight = ocp.Preflight
+	}
+
+	live.InCluster = live.InCluster || ocp.InCluster
+
+	return &live
 }
 
 // OpenShiftFileCollectorConfig builds upon FileCollectorConfig
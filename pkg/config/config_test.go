@@ -69,6 +69,7 @@ func TestMustLoadConfig(t *testing.T) {
 						BatchSize:                 500,
 						BatchSizeSmall:            100,
 						BatchSizeClusterImpact:    10,
+						RuntimeSockets:            DefaultRuntimeSockets,
 					},
 				},
 			},
@@ -92,6 +93,14 @@ func TestMustLoadConfig(t *testing.T) {
 						PageBufferSize:     10,
 						RateLimitPerSecond: 100,
 					},
+					CustomResources: []CustomResourceConfig{
+						{
+							Group:    "argoproj.io",
+							Version:  "v1alpha1",
+							Resource: "applications",
+							Kind:     "Application",
+						},
+					},
 				},
 				MongoDB: MongoDBConfig{
 					URL:               "mongodb://localhost:27017",
@@ -122,6 +131,7 @@ func TestMustLoadConfig(t *testing.T) {
 						BatchSize:                 1000,
 						BatchSizeSmall:            100,
 						BatchSizeClusterImpact:    5,
+						RuntimeSockets:            []string{"/run/containerd/containerd.sock", "/run/k3s/containerd/containerd.sock"},
 					},
 				},
 			},
@@ -152,3 +162,77 @@ func TestMustLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectorConfig_OpenShiftLive(t *testing.T) {
+	t.Parallel()
+
+	cfg := CollectorConfig{
+		Live: &K8SAPICollectorConfig{
+			PageSize:           DefaultK8sAPIPageSize,
+			PageBufferSize:     DefaultK8sAPIPageBufferSize,
+			RateLimitPerSecond: DefaultK8sAPIRateLimitPerSecond,
+			Kubeconfig:         "/path/to/kubeconfig",
+			Context:            "k8s",
+		},
+	}
+
+	// Falls back to the K8s live collector configuration
+	assert.Equal(t, *cfg.Live, *cfg.OpenShiftLive())
+
+	cfg.LiveOpenShift = &OpenShiftAPICollectorConfig{
+		K8SAPICollectorConfig: K8SAPICollectorConfig{
+			Context: "openshift",
+		},
+	}
+
+	live := cfg.OpenShiftLive()
+	assert.Equal(t, "openshift", live.Context)
+	assert.Equal(t, "/path/to/kubeconfig", live.Kubeconfig)
+	assert.Equal(t, DefaultK8sAPIRateLimitPerSecond, live.RateLimitPerSecond)
+	assert.Equal(t, "k8s", cfg.Live.Context)
+}
+
+func TestCollectorConfig_ForCluster(t *testing.T) {
+	t.Parallel()
+
+	cfg := CollectorConfig{
+		Type: CollectorTypeK8sAPI,
+		Live: &K8SAPICollectorConfig{
+			RateLimitPerSecond: DefaultK8sAPIRateLimitPerSecond,
+			Context:            "default",
+			ClusterName:        "default-cluster",
+		},
+		File: &FileCollectorConfig{
+			ClusterName: "file-cluster",
+			Files:       []string{"/path/to/cluster.json"},
+		},
+		Clusters: []ClusterConfig{
+			{Context: "prod"},
+			{Name: "staging", Context: "stg"},
+			{Directory: "/dumps/dev.tar.gz"},
+		},
+	}
+
+	prod := cfg.ForCluster(cfg.Clusters[0])
+	assert.Equal(t, "prod", prod.Live.Context)
+	assert.Empty(t, prod.Live.ClusterName)
+	assert.Equal(t, DefaultK8sAPIRateLimitPerSecond, prod.Live.RateLimitPerSecond)
+	assert.Empty(t, prod.Clusters)
+
+	staging := cfg.ForCluster(cfg.Clusters[1])
+	assert.Equal(t, "stg", staging.Live.Context)
+	assert.Equal(t, "staging", staging.Live.ClusterName)
+	assert.Equal(t, "staging", staging.File.ClusterName)
+
+	dev := cfg.ForCluster(cfg.Clusters[2])
+	assert.Equal(t, "/dumps/dev.tar.gz", dev.File.Directory)
+	assert.Equal(t, "dev", dev.File.ClusterName)
+	assert.Empty(t, dev.File.Files)
+
+	// The original configuration is left untouched
+	assert.Equal(t, "default", cfg.Live.Context)
+	assert.Equal(t, "default-cluster", cfg.Live.ClusterName)
+	assert.Equal(t, "file-cluster", cfg.File.ClusterName)
+	assert.Len(t, cfg.File.Files, 1)
+	assert.Len(t, cfg.Clusters, 3)
+}
@@ -20,3 +20,10 @@ func WithClusterName(cluster string) DynamicOption {
 		c.Cluster = cluster
 	}
 }
+
+// WithRunID is a functional option for configuring the run ID, e.g to share a single run ID across multiple clusters.
+func WithRunID(runID *RunID) DynamicOption {
+	return func(c *DynamicConfig) {
+		c.RunID = runID
+	}
+}
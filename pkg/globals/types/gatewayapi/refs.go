@@ -0,0 +1,34 @@
+package gatewayapi
+
+const (
+	KindGateway = "Gateway"
+	KindService = "Service"
+)
+
+// IsGatewayRef reports whether a parent reference targets a Gateway, the default when no group and kind are provided.
+func IsGatewayRef(ref ParentReference) bool {
+	if ref.Group != nil && *ref.Group != GroupName {
+		return false
+	}
+
+	return ref.Kind == nil || *ref.Kind == KindGateway
+}
+
+// IsServiceRef reports whether a backend reference targets a core Service, the default when no group and kind are
+// provided.
+func IsServiceRef(ref BackendObjectReference) bool {
+	if ref.Group != nil && *ref.Group != "" {
+		return false
+	}
+
+	return ref.Kind == nil || *ref.Kind == KindService
+}
+
+// RefNamespace returns the namespace of a reference, defaulting to the namespace of the referencing object.
+func RefNamespace(namespace *string, local string) string {
+	if namespace == nil || len(*namespace) == 0 {
+		return local
+	}
+
+	return *namespace
+}
@@ -0,0 +1,103 @@
+// Package gatewayapi holds a minimal mirror of the Kubernetes Gateway API (gateway.networking.k8s.io/v1) objects used
+// by the collectors. Only the fields required to resolve the traffic entry points of a cluster are represented, which
+// avoids a dependency on the Gateway API module and its controller-runtime requirements.
+package gatewayapi
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	GroupName = "gateway.networking.k8s.io"
+	Version   = "v1"
+)
+
+var (
+	GatewayResource   = schema.GroupVersionResource{Group: GroupName, Version: Version, Resource: "gateways"}
+	HTTPRouteResource = schema.GroupVersionResource{Group: GroupName, Version: Version, Resource: "httproutes"}
+)
+
+// Gateway represents an instance of a service-traffic handling infrastructure by binding listeners to a set of IP addresses.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GatewaySpec `json:"spec"`
+}
+
+// GatewaySpec defines the desired state of a Gateway.
+type GatewaySpec struct {
+	GatewayClassName string     `json:"gatewayClassName"`
+	Listeners        []Listener `json:"listeners"`
+}
+
+// Listener embodies the concept of a logical endpoint where a Gateway accepts network connections.
+type Listener struct {
+	Name     string  `json:"name"`
+	Hostname *string `json:"hostname,omitempty"`
+	Port     int32   `json:"port"`
+	Protocol string  `json:"protocol"`
+}
+
+// GatewayList contains a list of Gateway.
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Gateway `json:"items"`
+}
+
+// HTTPRoute provides a way to route HTTP requests from a Gateway listener to a backend.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HTTPRouteSpec `json:"spec"`
+}
+
+// HTTPRouteSpec defines the desired state of an HTTPRoute.
+type HTTPRouteSpec struct {
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string          `json:"hostnames,omitempty"`
+	Rules      []HTTPRouteRule   `json:"rules,omitempty"`
+}
+
+// ParentReference identifies a parent resource (usually a Gateway) the route wants to be attached to.
+type ParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+// HTTPRouteRule defines the backends HTTP requests matching the rule are forwarded to.
+type HTTPRouteRule struct {
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPBackendRef defines how an HTTPRoute forwards an HTTP request.
+type HTTPBackendRef struct {
+	BackendObjectReference `json:",inline"`
+
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// BackendObjectReference defines how an ObjectReference that is specific to a backend is referenced (a service by default).
+type BackendObjectReference struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+}
+
+// HTTPRouteList contains a list of HTTPRoute.
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []HTTPRoute `json:"items"`
+}
@@ -1,10 +1,18 @@
 package types
 
 import (
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
 	routev1 "github.com/openshift/api/route/v1"
+	userv1 "github.com/openshift/api/user/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 type PodType *corev1.Pod
@@ -16,19 +24,60 @@ type RoleBindingType *rbacv1.RoleBinding
 type ClusterRoleType *rbacv1.ClusterRole
 type ClusterRoleBindingType *rbacv1.ClusterRoleBinding
 type EndpointType *discoveryv1.EndpointSlice
+type NamespaceType *corev1.Namespace
+type ServiceType *corev1.Service
+type SecretType *corev1.Secret
+type DeploymentType *appsv1.Deployment
+type DaemonSetType *appsv1.DaemonSet
+type StatefulSetType *appsv1.StatefulSet
+type ReplicaSetType *appsv1.ReplicaSet
+type JobType *batchv1.Job
+type CronJobType *batchv1.CronJob
+type IngressType *networkingv1.Ingress
+type GatewayType *gatewayapi.Gateway
+type HTTPRouteType *gatewayapi.HTTPRoute
+type NetworkPolicyType *networkingv1.NetworkPolicy
+type MutatingWebhookConfigurationType *admissionregistrationv1.MutatingWebhookConfiguration
+type ValidatingWebhookConfigurationType *admissionregistrationv1.ValidatingWebhookConfiguration
+type CustomResourceType *unstructured.Unstructured
 
 // Openshift specific
 type RouteType *routev1.Route
+type GroupType *userv1.Group
 
 type InputType interface {
-	PodType | NodeType | ContainerType | VolumeMountType | RoleType | RoleBindingType | ClusterRoleType | ClusterRoleBindingType | EndpointType | RouteType
+	PodType | NodeType | ContainerType | VolumeMountType | RoleType | RoleBindingType | ClusterRoleType | ClusterRoleBindingType | EndpointType | NamespaceType | ServiceType | SecretType |
+		DeploymentType | DaemonSetType | StatefulSetType | ReplicaSetType | JobType | CronJobType | IngressType | GatewayType | HTTPRouteType | NetworkPolicyType |
+		MutatingWebhookConfigurationType | ValidatingWebhookConfigurationType | CustomResourceType | RouteType | GroupType
+}
+
+// WorkloadType holds the workload controller types managing pods from a pod template.
+type WorkloadType interface {
+	DeploymentType | DaemonSetType | StatefulSetType | ReplicaSetType | JobType | CronJobType
 }
 
 // Openshift specific types for ListInputType
 type openshiftListInputType interface {
-	routev1.RouteList
+	routev1.RouteList | userv1.GroupList
+}
+
+// Openshift specific types for ListItemInputType
+type openshiftListItemInputType interface {
+	routev1.Route | userv1.Group
 }
 
 type ListInputType interface {
-	corev1.PodList | corev1.NodeList | rbacv1.RoleList | rbacv1.RoleBindingList | rbacv1.ClusterRoleList | rbacv1.ClusterRoleBindingList | discoveryv1.EndpointSliceList | openshiftListInputType
+	corev1.PodList | corev1.NodeList | rbacv1.RoleList | rbacv1.RoleBindingList | rbacv1.ClusterRoleList | rbacv1.ClusterRoleBindingList | discoveryv1.EndpointSliceList | corev1.NamespaceList | corev1.ServiceList | corev1.SecretList |
+		appsv1.DeploymentList | appsv1.DaemonSetList | appsv1.StatefulSetList | appsv1.ReplicaSetList | batchv1.JobList | batchv1.CronJobList |
+		networkingv1.IngressList | gatewayapi.GatewayList | gatewayapi.HTTPRouteList | networkingv1.NetworkPolicyList |
+		admissionregistrationv1.MutatingWebhookConfigurationList | admissionregistrationv1.ValidatingWebhookConfigurationList |
+		openshiftListInputType
+}
+
+type ListItemInputType interface {
+	corev1.Pod | corev1.Node | rbacv1.Role | rbacv1.RoleBinding | rbacv1.ClusterRole | rbacv1.ClusterRoleBinding | discoveryv1.EndpointSlice | corev1.Namespace | corev1.Service | corev1.Secret |
+		appsv1.Deployment | appsv1.DaemonSet | appsv1.StatefulSet | appsv1.ReplicaSet | batchv1.Job | batchv1.CronJob |
+		networkingv1.Ingress | gatewayapi.Gateway | gatewayapi.HTTPRoute | networkingv1.NetworkPolicy |
+		admissionregistrationv1.MutatingWebhookConfiguration | admissionregistrationv1.ValidatingWebhookConfiguration | unstructured.Unstructured | metav1.APIResourceList |
+		openshiftListItemInputType
 }
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+)
+
+// clusterResult holds the outcome of the processing of a single cluster in a run.
+type clusterResult struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// runSummary accumulates the per-cluster outcomes of a run.
+type runSummary struct {
+	results []clusterResult
+}
+
+// add records the outcome of the processing of a single cluster.
+func (s *runSummary) add(name string, duration time.Duration, err error) {
+	s.results = append(s.results, clusterResult{
+		Name:     name,
+		Duration: duration,
+		Err:      err,
+	})
+}
+
+// failed returns the names of the clusters that could not be processed.
+func (s *runSummary) failed() []string {
+	failed := make([]string, 0)
+	for _, r := range s.results {
+		if r.Err != nil {
+			failed = append(failed, r.Name)
+		}
+	}
+
+	return failed
+}
+
+// Err returns an error listing the failed clusters, or nil if all clusters were processed successfully.
+func (s *runSummary) Err() error {
+	failed := s.failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d/%d clusters failed: %s", len(failed), len(s.results), strings.Join(failed, ", "))
+}
+
+// Log outputs the per-cluster successes and failures of the run.
+func (s *runSummary) Log() {
+	log.I.Infof("Run summary: %d/%d clusters processed successfully", len(s.results)-len(s.failed()), len(s.results))
+	for _, r := range s.results {
+		if r.Err != nil {
+			log.I.Errorf("  [FAILED]  %s (%s): %v", r.Name, r.Duration, r.Err)
+
+			continue
+		}
+
+		log.I.Infof("  [SUCCESS] %s (%s)", r.Name, r.Duration)
+	}
+}
+
+// clusterTargets returns the clusters to process in the run. Without an explicit list of clusters in the configuration,
+// the single cluster targeted by the collector configuration is processed.
+func clusterTargets(cfg *config.KubehoundConfig) []config.ClusterConfig {
+	if len(cfg.Collector.Clusters) == 0 {
+		return []config.ClusterConfig{{}}
+	}
+
+	return cfg.Collector.Clusters
+}
+
+// clusterTargetName returns a name identifying the cluster target before its cluster information is available.
+func clusterTargetName(target config.ClusterConfig) string {
+	switch {
+	case target.Name != "":
+		return target.Name
+	case target.Context != "":
+		return target.Context
+	case target.Directory != "":
+		return target.Directory
+	default:
+		return config.DefaultClusterName
+	}
+}
+
+// runCluster collects the data of a single cluster, ingests it and constructs its attack graph. The intermediate store
+// and cache are reset for each cluster, while the graph is shared by all the clusters of the run.
+// Returns the name of the processed cluster (if known) alongside any error.
+func runCluster(ctx context.Context, cfg *config.KubehoundConfig, runID *config.RunID,
+	sp storedb.Provider, gp graphdb.Provider) (string, error) {
+
+	// Reset the intermediate store, which only holds the data of the cluster being processed
+	err := sp.Prepare(ctx)
+	if err != nil {
+		return "", fmt.Errorf("store database prepare: %w", err)
+	}
+
+	// Create the cache client
+	log.I.Info("Loading cache provider")
+	cp, err := cache.Factory(ctx, cfg)
+	if err != nil {
+		return "", fmt.Errorf("cache client creation: %w", err)
+	}
+	defer cp.Close(ctx)
+	log.I.Infof("Loaded %s cache provider", cp.Name())
+
+	// Create the collector instance
+	log.I.Info("Loading Kubernetes data collector client")
+	collect, err := collector.ClientFactory(ctx, cfg)
+	if err != nil {
+		return "", fmt.Errorf("collector client creation: %w", err)
+	}
+	defer collect.Close(ctx)
+	log.I.Infof("Loaded %s collector client", collect.Name())
+
+	// All dependencies are loaded - we can complete the config with runtime information
+	cluster, err := collect.ClusterInfo(ctx)
+	if err != nil {
+		return "", fmt.Errorf("collector cluster info: %w", err)
+	}
+	cfg.ComputeDynamic(config.WithClusterName(cluster.Name), config.WithRunID(runID))
+
+	// Run the ingest pipeline
+	log.I.Infof("Starting Kubernetes raw data ingest (cluster: %s)", cluster.Name)
+	if err := ingestData(ctx, cfg, collect, cp, sp, gp); err != nil {
+		return cluster.Name, fmt.Errorf("raw data ingest: %w", err)
+	}
+
+	// Construct the graph
+	log.I.Infof("Building attack graph (cluster: %s)", cluster.Name)
+	if err := buildGraph(ctx, cfg, sp, gp, cp); err != nil {
+		return cluster.Name, fmt.Errorf("building attack graph: %w", err)
+	}
+
+	return cluster.Name, nil
+}
+
+// runClusters processes all the clusters of the run in sequence, continuing on failures, and returns the run summary.
+func runClusters(ctx context.Context, cfg *config.KubehoundConfig, runID *config.RunID,
+	sp storedb.Provider, gp graphdb.Provider) *runSummary {
+
+	base := cfg.Collector
+	defer func() { cfg.Collector = base }()
+
+	summary := &runSummary{}
+	for _, target := range clusterTargets(cfg) {
+		if err := ctx.Err(); err != nil {
+			summary.add(clusterTargetName(target), 0, err)
+
+			continue
+		}
+
+		start := time.Now()
+		cfg.Collector = base.ForCluster(target)
+
+		name, err := runCluster(ctx, cfg, runID, sp, gp)
+		if name == "" {
+			name = clusterTargetName(target)
+		}
+
+		if err != nil {
+			log.I.Errorf("Processing of cluster %s failed: %v", name, err)
+		}
+
+		summary.add(name, time.Since(start), err)
+	}
+
+	return summary
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRunSummary_Err(t *testing.T) {
+	t.Parallel()
+
+	summary := &runSummary{}
+	assert.NoError(t, summary.Err())
+
+	summary.add("prod", time.Second, nil)
+	summary.add("staging", time.Second, errors.New("collector cluster info: unreachable"))
+	summary.add("dev", time.Second, nil)
+
+	assert.Equal(t, []string{"staging"}, summary.failed())
+	assert.EqualError(t, summary.Err(), "1/3 clusters failed: staging")
+}
+
+func TestClusterTargets(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.KubehoundConfig{}
+	assert.Equal(t, []config.ClusterConfig{{}}, clusterTargets(cfg))
+	assert.Equal(t, config.DefaultClusterName, clusterTargetName(config.ClusterConfig{}))
+
+	cfg.Collector.Clusters = []config.ClusterConfig{
+		{Context: "prod"},
+		{Directory: "/dumps/dev"},
+		{Name: "staging", Context: "stg"},
+	}
+	targets := clusterTargets(cfg)
+	assert.Len(t, targets, 3)
+	assert.Equal(t, "prod", clusterTargetName(targets[0]))
+	assert.Equal(t, "/dumps/dev", clusterTargetName(targets[1]))
+	assert.Equal(t, "staging", clusterTargetName(targets[2]))
+}
+
+func TestClusters_NodeIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := &config.KubehoundConfig{}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	// Each cluster of the run has its own cache, and so its own store id of the default node identity (if any)
+	clusters := []struct {
+		name     string
+		identity primitive.ObjectID
+	}{
+		{name: "no-node-identity", identity: primitive.NilObjectID},
+		{name: "prod", identity: store.ObjectID()},
+		{name: "staging", identity: store.ObjectID()},
+	}
+	for _, cluster := range clusters {
+		cp, err := cache.Factory(ctx, cfg)
+		assert.NoError(t, err)
+
+		if !cluster.identity.IsZero() {
+			w, err := cp.BulkWriter(ctx)
+			assert.NoError(t, err)
+			assert.NoError(t, w.Queue(ctx, cachekey.Identity(libkube.DefaultNodeGroup, libkube.DefaultNodeNamespace), cluster.identity.Hex()))
+			assert.NoError(t, w.Close(ctx))
+		}
+
+		cfg.ComputeDynamic(config.WithClusterName(cluster.name))
+		output, err := converter.NewStoreWithCache(cfg, cp).Node(ctx, node)
+		assert.NoError(t, err)
+		assert.Equal(t, cluster.identity, output.UserId, cluster.name)
+		assert.NoError(t, cp.Close(ctx))
+	}
+}
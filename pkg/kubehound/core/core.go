@@ -86,6 +86,50 @@ func buildGraph(ctx context.Context, cfg *config.KubehoundConfig, storedb stored
 	return nil
 }
 
+// loadConfig loads the application configuration from the launch options and updates the logger behaviour accordingly.
+func loadConfig(lOpts *launchConfig) *config.KubehoundConfig {
+	var cfg *config.KubehoundConfig
+	if len(lOpts.ConfigPath) != 0 {
+		log.I.Infof("Loading application configuration from file %s", lOpts.ConfigPath)
+		cfg = config.MustLoadConfig(lOpts.ConfigPath)
+	} else {
+		log.I.Infof("Loading application configuration from default embedded")
+		cfg = config.MustLoadEmbedConfig()
+	}
+
+	// Update the logger behaviour from configuration
+	log.SetDD(cfg.Telemetry.Enabled)
+	log.AddGlobalTags(cfg.Telemetry.Tags)
+
+	// Apply the live collector connection overrides from the command line
+	for _, live := range []*config.K8SAPICollectorConfig{cfg.Collector.Live, liveOpenShift(cfg)} {
+		if live == nil {
+			continue
+		}
+
+		if lOpts.Kubeconfig != "" {
+			live.Kubeconfig = lOpts.Kubeconfig
+		}
+
+		if lOpts.Context != "" {
+			live.Context = lOpts.Context
+		}
+
+		live.InCluster = live.InCluster || lOpts.InCluster
+	}
+
+	return cfg
+}
+
+// liveOpenShift returns the OpenShift specific live collector configuration if provided.
+func liveOpenShift(cfg *config.KubehoundConfig) *config.K8SAPICollectorConfig {
+	if cfg.Collector.LiveOpenShift == nil {
+		return nil
+	}
+
+	return &cfg.Collector.LiveOpenShift.K8SAPICollectorConfig
+}
+
 // Launch will launch the KubeHound application to ingest data from a collector and create an attack graph.
 func Launch(ctx context.Context, opts ...LaunchOption) error {
 	span, ctx := tracer.StartSpanFromContext(ctx, span.Launch, tracer.Measured())
@@ -113,18 +157,7 @@ func Launch(ctx context.Context, opts ...LaunchOption) error {
 		opt(lOpts)
 	}
 
-	var cfg *config.KubehoundConfig
-	if len(lOpts.ConfigPath) != 0 {
-		log.I.Infof("Loading application configuration from file %s", lOpts.ConfigPath)
-		cfg = config.MustLoadConfig(lOpts.ConfigPath)
-	} else {
-		log.I.Infof("Loading application configuration from default embedded")
-		cfg = config.MustLoadEmbedConfig()
-	}
-
-	// Update the logger behaviour from configuration
-	log.SetDD(cfg.Telemetry.Enabled)
-	log.AddGlobalTags(cfg.Telemetry.Tags)
+	cfg := loadConfig(lOpts)
 
 	// Setup telemetry
 	log.I.Info("Initializing application telemetry")
@@ -134,15 +167,6 @@ func Launch(ctx context.Context, opts ...LaunchOption) error {
 	}
 	defer telemetry.Shutdown(ts)
 
-	// Create the cache client
-	log.I.Info("Loading cache provider")
-	cp, err := cache.Factory(ctx, cfg)
-	if err != nil {
-		return fmt.Errorf("cache client creation: %w", err)
-	}
-	defer cp.Close(ctx)
-	log.I.Infof("Loaded %s cache provider", cp.Name())
-
 	// Create the store client
 	log.I.Info("Loading store database provider")
 	sp, err := storedb.Factory(ctx, cfg)
@@ -152,11 +176,6 @@ func Launch(ctx context.Context, opts ...LaunchOption) error {
 	defer sp.Close(ctx)
 	log.I.Infof("Loaded %s store provider", sp.Name())
 
-	err = sp.Prepare(ctx)
-	if err != nil {
-		return fmt.Errorf("store database prepare: %w", err)
-	}
-
 	// Create the graph client
 	log.I.Info("Loading graph database provider")
 	gp, err := graphdb.Factory(ctx, cfg)
@@ -166,37 +185,17 @@ func Launch(ctx context.Context, opts ...LaunchOption) error {
 	defer gp.Close(ctx)
 	log.I.Infof("Loaded %s graph provider", gp.Name())
 
+	// The graph is shared by all the clusters of the run so only prepare it once
 	err = gp.Prepare(ctx)
 	if err != nil {
 		return fmt.Errorf("graph database prepare: %w", err)
 	}
 
-	// Create the collector instance
-	log.I.Info("Loading Kubernetes data collector client")
-	collect, err := collector.ClientFactory(ctx, cfg)
-	if err != nil {
-		return fmt.Errorf("collector client creation: %w", err)
-	}
-	defer collect.Close(ctx)
-	log.I.Infof("Loaded %s collector client", collect.Name())
-
-	// All dependencies are loaded - we can complete the config with runtime information
-	cluster, err := collect.ClusterInfo(ctx)
-	if err != nil {
-		return fmt.Errorf("collector cluster info: %w", err)
-	}
-	cfg.ComputeDynamic(config.WithClusterName(cluster.Name))
-
-	// Run the ingest pipeline
-	log.I.Info("Starting Kubernetes raw data ingest")
-	if err := ingestData(ctx, cfg, collect, cp, sp, gp); err != nil {
-		return fmt.Errorf("raw data ingest: %w", err)
-	}
-
-	// Construct the graph
-	log.I.Info("Building attack graph")
-	if err := buildGraph(ctx, cfg, sp, gp, cp); err != nil {
-		return fmt.Errorf("building attack graph: %w", err)
+	// Collect, ingest and build the attack graph of each cluster in turn
+	summary := runClusters(ctx, cfg, runID, sp, gp)
+	summary.Log()
+	if err := summary.Err(); err != nil {
+		return err
 	}
 
 	log.I.Infof("KubeHound run (id=%s) complete in %s", runID.String(), time.Since(start))
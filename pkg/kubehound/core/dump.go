@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/telemetry"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+	"github.com/DataDog/KubeHound/pkg/telemetry/span"
+
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+)
+
+// Dump will collect all the K8s data from a live collector and write it to the provided output (directory or .tar.gz
+// archive) using the layout expected by the file collectors, for later ingestion in a separate environment.
+func Dump(ctx context.Context, output string, opts ...LaunchOption) error {
+	span, ctx := tracer.StartSpanFromContext(ctx, span.Dump, tracer.Measured())
+	defer span.Finish()
+
+	start := time.Now()
+	log.I.Info("Initializing launch options")
+	lOpts := &launchConfig{}
+	for _, opt := range opts {
+		opt(lOpts)
+	}
+
+	cfg := loadConfig(lOpts)
+
+	switch cfg.Collector.Type {
+	case config.CollectorTypeK8sAPI, config.CollectorTypeOpenShiftAPI:
+	default:
+		return fmt.Errorf("dump requires a live collector, got collector type: %s", cfg.Collector.Type)
+	}
+
+	// Setup telemetry
+	log.I.Info("Initializing application telemetry")
+	ts, err := telemetry.Initialize(cfg)
+	if err != nil {
+		log.I.Warnf("failed telemetry initialization: %v", err)
+	}
+	defer telemetry.Shutdown(ts)
+
+	// Create the collector instance
+	log.I.Info("Loading Kubernetes data collector client")
+	collect, err := collector.ClientFactory(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("collector client creation: %w", err)
+	}
+	defer collect.Close(ctx)
+	log.I.Infof("Loaded %s collector client", collect.Name())
+
+	log.I.Info("Running dependency health checks")
+	if _, err := collect.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("collector health check: %w", err)
+	}
+
+	w, err := collector.NewDumpWriter(output)
+	if err != nil {
+		return fmt.Errorf("dump writer creation: %w", err)
+	}
+
+	log.I.Infof("Dumping Kubernetes data to %s", output)
+	if err := collector.Dump(ctx, collect, w); err != nil {
+		return fmt.Errorf("dump: %w", err)
+	}
+
+	log.I.Infof("KubeHound dump to %s complete in %s", output, time.Since(start))
+
+	return nil
+}
@@ -2,6 +2,9 @@ package core
 
 type launchConfig struct {
 	ConfigPath string
+	Kubeconfig string
+	Context    string
+	InCluster  bool
 }
 
 type LaunchOption func(*launchConfig)
@@ -12,3 +15,24 @@ func WithConfigPath(configPath string) LaunchOption {
 		lc.ConfigPath = configPath
 	}
 }
+
+// WithKubeconfig sets the kubeconfig file used by the live collectors, overriding the config file value.
+func WithKubeconfig(kubeconfig string) LaunchOption {
+	return func(lc *launchConfig) {
+		lc.Kubeconfig = kubeconfig
+	}
+}
+
+// WithContext sets the kubeconfig context used by the live collectors, overriding the config file value.
+func WithContext(context string) LaunchOption {
+	return func(lc *launchConfig) {
+		lc.Context = context
+	}
+}
+
+// WithInCluster enables the in-cluster service account authentication of the live collectors.
+func WithInCluster(inCluster bool) LaunchOption {
+	return func(lc *launchConfig) {
+		lc.InCluster = inCluster
+	}
+}
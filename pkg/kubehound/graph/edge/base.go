@@ -7,11 +7,13 @@ import (
 )
 
 type BaseEdge struct {
-	cfg *config.EdgeBuilderConfig
+	cfg     *config.EdgeBuilderConfig
+	runtime *config.DynamicConfig
 }
 
-func (e *BaseEdge) Initialize(cfg *config.EdgeBuilderConfig) error {
+func (e *BaseEdge) Initialize(cfg *config.EdgeBuilderConfig, runtime *config.DynamicConfig) error {
 	e.cfg = cfg
+	e.runtime = runtime
 
 	return nil
 }
@@ -23,3 +25,9 @@ func (e *BaseEdge) BatchSize() int {
 func (e *BaseEdge) Traversal() types.EdgeTraversal {
 	return adapter.DefaultEdgeTraversal()
 }
+
+// cluster returns the name of the cluster being processed, used to scope traversals over the whole graph as multiple
+// clusters can share the same graph.
+func (e *BaseEdge) cluster() string {
+	return e.runtime.Cluster
+}
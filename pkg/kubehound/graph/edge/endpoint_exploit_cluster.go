@@ -0,0 +1,78 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	Register(&EndpointExploitCluster{}, RegisterDefault)
+}
+
+// EndpointExploitCluster links the endpoints of the EndpointSlices backing services only reachable from within the
+// cluster (ClusterIP, headless and ExternalName services) to the container exposing them. Endpoints isolated from the
+// other pods of the cluster by network policies are skipped and the remaining edges are annotated with the access
+// granted by the network policies.
+type EndpointExploitCluster struct {
+	BaseEdge
+}
+
+func (e *EndpointExploitCluster) Label() string {
+	return "ENDPOINT_EXPLOIT"
+}
+
+func (e *EndpointExploitCluster) Name() string {
+	return "EndpointExploitCluster"
+}
+
+func (e *EndpointExploitCluster) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*sliceEndpointGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	processed, err := adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Endpoint, typed.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	processed[networkPolicyProperty] = typed.NetworkPolicy
+
+	return processed, nil
+}
+
+func (e *EndpointExploitCluster) Stream(ctx context.Context, store storedb.Provider, c cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	db := adapter.MongoDB(store)
+	endpoints := db.Collection(collections.EndpointName)
+
+	// Network policies can isolate the endpoints of services only reachable from within the cluster
+	policies, err := newEndpointPolicyEvaluator(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	// Match only endpoints with a matching EndpointSlice backing a service NOT exposed outside the cluster
+	pipeline := sliceEndpointPipeline(bson.M{
+		"has_slice": true,
+		"access":    bson.M{"$lt": shared.EndpointExposureNodeIP},
+	})
+
+	cur, err := endpoints.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[sliceEndpointGroup](ctx, cur, policies.Filter(callback), complete)
+}
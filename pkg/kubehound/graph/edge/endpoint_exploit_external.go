@@ -6,12 +6,15 @@ import (
 
 	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
 	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
 	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	discoveryv1 "k8s.io/api/discovery/v1"
 )
 
 func init() {
@@ -23,8 +26,25 @@ type EndpointExploitExternal struct {
 }
 
 type sliceEndpointGroup struct {
-	Endpoint  primitive.ObjectID `bson:"_id" json:"endpoint_id"`
-	Container primitive.ObjectID `bson:"container_id" json:"container_id"`
+	Endpoint          primitive.ObjectID       `bson:"_id" json:"endpoint_id"`
+	Container         primitive.ObjectID       `bson:"container_id" json:"container_id"`
+	ContainerPortName string                   `bson:"container_port_name" json:"container_port_name"`
+	PodName           string                   `bson:"pod_name" json:"pod_name"`
+	PodNamespace      string                   `bson:"pod_namespace" json:"pod_namespace"`
+	Port              discoveryv1.EndpointPort `bson:"port" json:"port"`
+	NetworkPolicy     string                   `bson:"-" json:"network_policy"`
+}
+
+var _ endpointPolicyTarget = (*sliceEndpointGroup)(nil)
+
+func (g *sliceEndpointGroup) policyTarget() (string, string, libkube.PolicyPort) {
+	// The EndpointSlice port name is the name of the service port, named ports of network policies reference the
+	// name of the container port instead
+	return g.PodNamespace, g.PodName, endpointPolicyPort(g.Port, g.ContainerPortName)
+}
+
+func (g *sliceEndpointGroup) setNetworkPolicy(access string) {
+	g.NetworkPolicy = access
 }
 
 func (e *EndpointExploitExternal) Label() string {
@@ -49,15 +69,47 @@ func (e *EndpointExploitExternal) Stream(ctx context.Context, store storedb.Prov
 
 	endpoints := adapter.MongoDB(store).Collection(collections.EndpointName)
 
+	// Match only endpoints with a matching EndpointSlice backing a service exposed outside the cluster
+	pipeline := sliceEndpointPipeline(bson.M{
+		"has_slice": true,
+		"access":    bson.M{"$gte": shared.EndpointExposureNodeIP},
+	})
+
+	cur, err := endpoints.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[sliceEndpointGroup](ctx, cur, callback, complete)
+}
+
+// sliceEndpointPipeline returns the aggregation pipeline matching the store.Endpoint documents selected by the provided
+// filter to the container exposing the endpoint.
+func sliceEndpointPipeline(match bson.M) []bson.M {
 	// K8s endpoint slices must be ingested before containers. In this stage we need to match store.Endpoint documents that
 	// are generated via K8s EndpointSlice objects and match them to the container exposing the endpoint. The other case of
 	// store.Endpoint documents not associated with an EndpointSlice is handled separately.
-	pipeline := []bson.M{
+	//
+	// Cannot use an $elemMatch with pipeline variables so use the more convoluted $filter syntax to match container port/protocol
+	// See: https://www.mongodb.com/community/forums/t/equivalent-of-elemmatch-query-operator-for-use-in-match-within-the-aggregation-lookup-with-pipeline/5360
+	matchPorts := bson.M{"$filter": bson.M{
+		"input": "$k8.ports",
+		"as":    "p",
+		"cond": bson.M{
+			"$and": bson.A{
+				bson.M{"$eq": bson.A{
+					"$$p.containerport", "$$port",
+				}},
+				bson.M{"$eq": bson.A{
+					"$$p.protocol", "$$proto",
+				}},
+			}},
+	}}
+
+	return []bson.M{
 		{
-			// Match only endpoints with a matching EndpointSlice
-			"$match": bson.M{
-				"has_slice": true,
-			},
+			"$match": match,
 		},
 		{
 			// Lookup the container matching the slice. This requires a match on namespace/pod/port/protocol
@@ -83,30 +135,25 @@ func (e *EndpointExploitExternal) Stream(ctx context.Context, store storedb.Prov
 								bson.M{"$ne": bson.A{
 									"$k8.ports", nil,
 								}},
-								// Cannot use an $elemMatch with pipeline variables so use the more convoluted $filter syntax to match container port/protocol
-								// See: https://www.mongodb.com/community/forums/t/equivalent-of-elemmatch-query-operator-for-use-in-match-within-the-aggregation-lookup-with-pipeline/5360
 								bson.M{"$gt": bson.A{
-									bson.M{"$size": bson.M{"$filter": bson.M{
-										"input": "$k8.ports",
-										"as":    "p",
-										"cond": bson.M{
-											"$and": bson.A{
-												bson.M{"$eq": bson.A{
-													"$$p.containerport", "$$port",
-												}},
-												bson.M{"$eq": bson.A{
-													"$$p.protocol", "$$proto",
-												}},
-											}},
-									}}},
+									bson.M{"$size": matchPorts},
 									0,
 								}},
 							},
 						}},
 					},
 					{
+						// Retain the name of the matching container port to evaluate the named ports of network policies
 						"$project": bson.M{
 							"_id": 1,
+							"port_name": bson.M{"$arrayElemAt": bson.A{
+								bson.M{"$map": bson.M{
+									"input": matchPorts,
+									"as":    "cp",
+									"in":    "$$cp.name",
+								}},
+								0,
+							}},
 						},
 					},
 				},
@@ -117,17 +164,13 @@ func (e *EndpointExploitExternal) Stream(ctx context.Context, store storedb.Prov
 		},
 		{
 			"$project": bson.M{
-				"_id":          1,
-				"container_id": "$matchContainers._id",
+				"_id":                 1,
+				"container_id":        "$matchContainers._id",
+				"container_port_name": "$matchContainers.port_name",
+				"pod_name":            1,
+				"pod_namespace":       1,
+				"port":                1,
 			},
 		},
 	}
-
-	cur, err := endpoints.Aggregate(ctx, pipeline)
-	if err != nil {
-		return err
-	}
-	defer cur.Close(ctx)
-
-	return adapter.MongoCursorHandler[sliceEndpointGroup](ctx, cur, callback, complete)
 }
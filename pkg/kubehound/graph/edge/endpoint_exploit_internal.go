@@ -6,6 +6,7 @@ import (
 
 	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
 	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
@@ -13,19 +14,43 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	discoveryv1 "k8s.io/api/discovery/v1"
 )
 
 func init() {
 	Register(&EndpointExploitInternal{}, RegisterDefault)
 }
 
+// EndpointExploitInternal links the private endpoints created from the container ports not backing any service to the
+// container exposing them. Endpoints isolated from the other pods of the cluster by network policies are skipped and
+// the remaining edges are annotated with the access granted by the network policies.
 type EndpointExploitInternal struct {
 	BaseEdge
 }
 
 type containerEndpointGroup struct {
-	Endpoint  primitive.ObjectID `bson:"_id" json:"endpoint_id"`
-	Container primitive.ObjectID `bson:"container_id" json:"container_id"`
+	Endpoint      primitive.ObjectID       `bson:"_id" json:"endpoint_id"`
+	Container     primitive.ObjectID       `bson:"container_id" json:"container_id"`
+	PodName       string                   `bson:"pod_name" json:"pod_name"`
+	PodNamespace  string                   `bson:"pod_namespace" json:"pod_namespace"`
+	Port          discoveryv1.EndpointPort `bson:"port" json:"port"`
+	NetworkPolicy string                   `bson:"-" json:"network_policy"`
+}
+
+var _ endpointPolicyTarget = (*containerEndpointGroup)(nil)
+
+func (g *containerEndpointGroup) policyTarget() (string, string, libkube.PolicyPort) {
+	// Private endpoints are created from the container port and so carry the container port name
+	name := ""
+	if g.Port.Name != nil {
+		name = *g.Port.Name
+	}
+
+	return g.PodNamespace, g.PodName, endpointPolicyPort(g.Port, name)
+}
+
+func (g *containerEndpointGroup) setNetworkPolicy(access string) {
+	g.NetworkPolicy = access
 }
 
 func (e *EndpointExploitInternal) Label() string {
@@ -42,13 +67,27 @@ func (e *EndpointExploitInternal) Processor(ctx context.Context, oic *converter.
 		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
 	}
 
-	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Endpoint, typed.Container)
+	processed, err := adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Endpoint, typed.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	processed[networkPolicyProperty] = typed.NetworkPolicy
+
+	return processed, nil
 }
 
 func (e *EndpointExploitInternal) Stream(ctx context.Context, store storedb.Provider, c cache.CacheReader,
 	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
 
-	endpoints := adapter.MongoDB(store).Collection(collections.EndpointName)
+	db := adapter.MongoDB(store)
+	endpoints := db.Collection(collections.EndpointName)
+
+	// Network policies can isolate the private endpoints from the other pods of the cluster
+	policies, err := newEndpointPolicyEvaluator(ctx, db)
+	if err != nil {
+		return err
+	}
 
 	// Collect the endpoints with no associated slice. These are directly created from a container port in the
 	// pod ingest pipeline and so already have an associated container ID we can use directly.
@@ -56,8 +95,9 @@ func (e *EndpointExploitInternal) Stream(ctx context.Context, store storedb.Prov
 		"has_slice": false,
 	}
 
-	// We just need a 1:1 mapping of the (private) endpoint and container to create this edge
-	projection := bson.M{"_id": 1, "container_id": 1}
+	// We just need a 1:1 mapping of the (private) endpoint and container to create this edge, along with the pod port
+	// targeted to evaluate the network policies
+	projection := bson.M{"_id": 1, "container_id": 1, "pod_name": 1, "pod_namespace": 1, "port": 1}
 
 	cur, err := endpoints.Find(ctx, filter, options.Find().SetProjection(projection))
 	if err != nil {
@@ -65,5 +105,5 @@ func (e *EndpointExploitInternal) Stream(ctx context.Context, store storedb.Prov
 	}
 	defer cur.Close(ctx)
 
-	return adapter.MongoCursorHandler[containerEndpointGroup](ctx, cur, callback, complete)
+	return adapter.MongoCursorHandler[containerEndpointGroup](ctx, cur, policies.Filter(callback), complete)
 }
@@ -0,0 +1,169 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+const (
+	// networkPolicyProperty is the ENDPOINT_EXPLOIT edge property holding the result of the network policy evaluation.
+	networkPolicyProperty = "networkPolicy"
+)
+
+// endpointPolicyTarget is implemented by the edge query results describing a pod port reachable from within the cluster.
+type endpointPolicyTarget interface {
+	// policyTarget returns the namespace and name of the pod exposing the endpoint and the targeted pod port.
+	policyTarget() (string, string, libkube.PolicyPort)
+
+	// setNetworkPolicy records the result of the network policy evaluation.
+	setNetworkPolicy(access string)
+}
+
+// policyPod holds the properties of a pod required to evaluate the network policies.
+type policyPod struct {
+	K8 struct {
+		ObjectMeta struct {
+			Name      string            `bson:"name"`
+			Namespace string            `bson:"namespace"`
+			Labels    map[string]string `bson:"labels"`
+		} `bson:"objectmeta"`
+		Spec struct {
+			HostNetwork bool `bson:"hostnetwork"`
+		} `bson:"spec"`
+	} `bson:"k8"`
+}
+
+// endpointPolicyEvaluator evaluates the ingress network policies of the cluster for traffic originating from the pods of
+// the cluster to the endpoints exposed by containers.
+type endpointPolicyEvaluator struct {
+	policies map[string][]*networkingv1.NetworkPolicy // Ingress network policies keyed by namespace
+	pods     map[string]*policyPod                    // Pods of the namespaces with ingress network policies keyed by namespace/name
+}
+
+// newEndpointPolicyEvaluator loads the ingress network policies and the pods they could select from the store.
+func newEndpointPolicyEvaluator(ctx context.Context, db *mongo.Database) (*endpointPolicyEvaluator, error) {
+	e := &endpointPolicyEvaluator{
+		policies: make(map[string][]*networkingv1.NetworkPolicy),
+		pods:     make(map[string]*policyPod),
+	}
+
+	projection := bson.M{"k8.objectmeta.name": 1, "k8.objectmeta.namespace": 1, "k8.spec": 1}
+	cur, err := db.Collection(collections.NetworkPolicyName).Find(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, fmt.Errorf("loading network policies: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	namespaces := bson.A{}
+	for cur.Next(ctx) {
+		var entry struct {
+			K8 networkingv1.NetworkPolicy `bson:"k8"`
+		}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, fmt.Errorf("decoding network policy: %w", err)
+		}
+
+		if !libkube.NetworkPolicyIngress(&entry.K8) {
+			continue
+		}
+
+		ns := entry.K8.Namespace
+		if _, ok := e.policies[ns]; !ok {
+			namespaces = append(namespaces, ns)
+		}
+		e.policies[ns] = append(e.policies[ns], &entry.K8)
+	}
+
+	if len(namespaces) == 0 {
+		return e, nil
+	}
+
+	// Only the labels of the pods in namespaces with ingress policies are required
+	filter := bson.M{"k8.objectmeta.namespace": bson.M{"$in": namespaces}}
+	projection = bson.M{"k8.objectmeta.name": 1, "k8.objectmeta.namespace": 1, "k8.objectmeta.labels": 1, "k8.spec.hostnetwork": 1}
+	pods, err := db.Collection(collections.PodName).Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, fmt.Errorf("loading network policy pods: %w", err)
+	}
+	defer pods.Close(ctx)
+
+	for pods.Next(ctx) {
+		pod := &policyPod{}
+		if err := pods.Decode(pod); err != nil {
+			return nil, fmt.Errorf("decoding network policy pod: %w", err)
+		}
+
+		e.pods[podKey(pod.K8.ObjectMeta.Namespace, pod.K8.ObjectMeta.Name)] = pod
+	}
+
+	return e, nil
+}
+
+func podKey(namespace string, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// Access returns the access granted by the network policies to the provided pod port. Network policies do not apply to
+// pods running in the host network namespace and unknown pods are considered reachable.
+func (e *endpointPolicyEvaluator) Access(namespace string, podName string, port libkube.PolicyPort) string {
+	policies, ok := e.policies[namespace]
+	if !ok {
+		return shared.NetworkPolicyAccessOpen
+	}
+
+	pod, ok := e.pods[podKey(namespace, podName)]
+	if !ok || pod.K8.Spec.HostNetwork {
+		return shared.NetworkPolicyAccessOpen
+	}
+
+	return libkube.NetworkPolicyAccess(policies, pod.K8.ObjectMeta.Labels, port)
+}
+
+// Filter wraps an edge entry callback to evaluate the network policies for each endpoint. Endpoints denying all the
+// traffic from the pods of the cluster are dropped and the others are annotated with the access granted by the policies.
+func (e *endpointPolicyEvaluator) Filter(callback types.ProcessEntryCallback) types.ProcessEntryCallback {
+	return func(ctx context.Context, entry types.DataContainer) error {
+		target, ok := entry.(endpointPolicyTarget)
+		if !ok {
+			return fmt.Errorf("invalid type passed to network policy filter: %T", entry)
+		}
+
+		access := e.Access(target.policyTarget())
+		if access == shared.NetworkPolicyAccessDenied {
+			return nil
+		}
+
+		target.setNetworkPolicy(access)
+
+		return callback(ctx, entry)
+	}
+}
+
+// endpointPolicyPort returns the pod port targeted by a store endpoint port.
+func endpointPolicyPort(port discoveryv1.EndpointPort, name string) libkube.PolicyPort {
+	output := libkube.PolicyPort{
+		Name:     name,
+		Protocol: corev1.ProtocolTCP,
+	}
+
+	if port.Port != nil {
+		output.Port = *port.Port
+	}
+
+	if port.Protocol != nil {
+		output.Protocol = *port.Protocol
+	}
+
+	return output
+}
@@ -0,0 +1,142 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	NetMitmLabel = "CE_NET_MITM"
+)
+
+// netMitmCapabilities holds the capabilities allowing to capture or redirect the traffic of the network namespace of
+// a container, which is the node network namespace for host network containers.
+var netMitmCapabilities = bson.A{"NET_RAW", "NET_ADMIN"}
+
+// netRawDrops holds the dropped capabilities removing NET_RAW from the default capabilities of the container runtime.
+var netRawDrops = bson.A{"NET_RAW", "ALL"}
+
+// netMitmMatch returns the filter matching the host network containers that can intercept the node traffic, i.e
+// privileged containers, containers explicitly granted one of the capabilities or containers keeping the NET_RAW
+// capability granted by default by the container runtime (unless dropped, or ALL dropped without re-adding it).
+func netMitmMatch() bson.M {
+	return bson.M{
+		"inherited.host_net": true,
+		"$or": bson.A{
+			bson.M{"k8.securitycontext.privileged": true},
+			bson.M{"k8.securitycontext.capabilities.add": bson.M{"$in": netMitmCapabilities}},
+			bson.M{"k8.securitycontext.capabilities.drop": bson.M{"$nin": netRawDrops}},
+		},
+	}
+}
+
+// netMitmSourcesStages returns the pipeline stages grouping the containers able to intercept the node traffic by the
+// provided node field. The targets are then looked up once per node rather than once per source container, as host
+// network daemonsets (e.g kube-proxy, CNI agents) add several sources to every node of the cluster.
+func netMitmSourcesStages(nodeField string) []bson.M {
+	return []bson.M{
+		{
+			"$match": netMitmMatch(),
+		},
+		{
+			"$group": bson.M{
+				"_id":     "$" + nodeField,
+				"sources": bson.M{"$push": "$_id"},
+			},
+		},
+	}
+}
+
+func init() {
+	Register(&EscapeNetMitm{}, RegisterDefault)
+}
+
+// EscapeNetMitm links privileged host network containers or host network containers with the NET_RAW or NET_ADMIN
+// capabilities to the other containers running on the same node, whose traffic can be intercepted.
+type EscapeNetMitm struct {
+	BaseEdge
+}
+
+type netMitmGroup struct {
+	Container primitive.ObjectID `bson:"_id" json:"container"`
+	Target    primitive.ObjectID `bson:"target_id" json:"target"`
+}
+
+func (e *EscapeNetMitm) Label() string {
+	return NetMitmLabel
+}
+
+func (e *EscapeNetMitm) Name() string {
+	return "ContainerEscapeNetMitm"
+}
+
+func (e *EscapeNetMitm) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*netMitmGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Container, typed.Target)
+}
+
+func (e *EscapeNetMitm) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	containers := adapter.MongoDB(store).Collection(collections.ContainerName)
+
+	// Interception is possible from a container sharing the host network namespace with the NET_RAW (sniffing, ARP
+	// spoofing) or NET_ADMIN (traffic redirection via iptables) capabilities, both granted to privileged containers and
+	// NET_RAW being granted by default by the container runtime. All other containers on the node are reachable through
+	// the node network interfaces.
+	pipeline := append(netMitmSourcesStages("node_id"),
+		bson.M{
+			"$lookup": bson.M{
+				"as":           "targets",
+				"from":         collections.ContainerName,
+				"localField":   "_id",
+				"foreignField": "node_id",
+				"pipeline": []bson.M{
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$unwind": "$sources",
+		},
+		bson.M{
+			"$unwind": "$targets",
+		},
+		bson.M{
+			"$match": bson.M{"$expr": bson.M{
+				"$ne": bson.A{"$sources", "$targets._id"},
+			}},
+		},
+		bson.M{
+			"$project": bson.M{
+				"_id":       "$sources",
+				"target_id": "$targets._id",
+			},
+		},
+	)
+
+	cur, err := containers.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[netMitmGroup](ctx, cur, callback, complete)
+}
@@ -0,0 +1,92 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	Register(&EscapeNetMitmEndpoint{}, RegisterDefault)
+}
+
+// EscapeNetMitmEndpoint links privileged host network containers or host network containers with the NET_RAW or
+// NET_ADMIN capabilities to the endpoints served from the same node, whose traffic can be intercepted.
+type EscapeNetMitmEndpoint struct {
+	BaseEdge
+}
+
+func (e *EscapeNetMitmEndpoint) Label() string {
+	return NetMitmLabel
+}
+
+func (e *EscapeNetMitmEndpoint) Name() string {
+	return "ContainerEscapeNetMitmEndpoint"
+}
+
+func (e *EscapeNetMitmEndpoint) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*netMitmGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Container, typed.Target)
+}
+
+// Stream finds all host network containers able to intercept the node traffic and the endpoints backed by the same
+// node, excluding the endpoints exposed by the container itself.
+func (e *EscapeNetMitmEndpoint) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	containers := adapter.MongoDB(store).Collection(collections.ContainerName)
+	pipeline := append(netMitmSourcesStages("inherited.node_name"),
+		bson.M{
+			"$lookup": bson.M{
+				"as":           "targets",
+				"from":         collections.EndpointName,
+				"localField":   "_id",
+				"foreignField": "node_name",
+				"pipeline": []bson.M{
+					{
+						"$project": bson.M{
+							"_id":          1,
+							"container_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$unwind": "$sources",
+		},
+		bson.M{
+			"$unwind": "$targets",
+		},
+		bson.M{
+			"$match": bson.M{"$expr": bson.M{
+				"$ne": bson.A{"$sources", "$targets.container_id"},
+			}},
+		},
+		bson.M{
+			"$project": bson.M{
+				"_id":       "$sources",
+				"target_id": "$targets._id",
+			},
+		},
+	)
+
+	cur, err := containers.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[netMitmGroup](ctx, cur, callback, complete)
+}
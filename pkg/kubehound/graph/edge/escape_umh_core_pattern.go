@@ -0,0 +1,108 @@
+package edge
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CorePatternMountList represents the host paths granting write access to /proc/sys/kernel/core_pattern when mounted
+// into a container. Paths are normalized by K8s to remove the trailing slash.
+var CorePatternMountList = bson.A{
+	"/",
+	"/proc",
+	"/proc/sys",
+	"/proc/sys/kernel",
+	"/proc/sys/kernel/core_pattern",
+}
+
+func init() {
+	Register(&EscapeUmhCorePattern{}, RegisterDefault)
+}
+
+type EscapeUmhCorePattern struct {
+	BaseContainerEscape
+}
+
+func (e *EscapeUmhCorePattern) Label() string {
+	return "CE_UMH_CORE_PATTERN"
+}
+
+func (e *EscapeUmhCorePattern) Name() string {
+	return "ContainerEscapeUmhCorePattern"
+}
+
+// Processor delegates the processing tasks to the generic containerEscapeProcessor.
+func (e *EscapeUmhCorePattern) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	return containerEscapeProcessor(ctx, oic, e.Label(), entry)
+}
+
+func (e *EscapeUmhCorePattern) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	containers := adapter.MongoDB(store).Collection(collections.ContainerName)
+
+	// Escape is possible if the host /proc/sys/kernel (or any parent directory) is mounted into the container with write
+	// permissions, allowing to register a usermode helper in core_pattern. The core_pattern setting is not namespaced
+	// and /proc/sys is not mounted read-only in privileged containers, which grants the same access.
+	pipeline := bson.A{
+		bson.M{
+			"$lookup": bson.M{
+				"as":   "procMounts",
+				"from": collections.VolumeName,
+				"let": bson.M{
+					"containerId": "$_id",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"$expr": bson.M{
+								"$eq": bson.A{
+									"$container_id", "$$containerId",
+								},
+							}},
+							bson.M{"type": shared.VolumeTypeHost},
+							bson.M{"readonly": false},
+							bson.M{"source": bson.M{"$in": CorePatternMountList}},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$match": bson.M{
+				"$or": bson.A{
+					bson.M{"k8.securitycontext.privileged": true},
+					bson.M{"procMounts": bson.M{"$ne": bson.A{}}},
+				},
+			},
+		},
+		// We just need a 1:1 mapping of the node and container to create this edge
+		bson.M{
+			"$project": bson.M{
+				"_id":     1,
+				"node_id": 1,
+			},
+		},
+	}
+
+	cur, err := containers.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[containerEscapeGroup](ctx, cur, callback, complete)
+}
@@ -89,25 +89,8 @@ func (e *EscapeVarLogSymlink) Stream(ctx context.Context, store storedb.Provider
 	pipeline := []bson.M{
 		{
 			"$match": bson.M{
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods/log"},
-								bson.M{"resources": "pods/*"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "get"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(podLogResources, "get"),
 				},
 			},
 		},
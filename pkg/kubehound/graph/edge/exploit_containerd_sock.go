@@ -0,0 +1,126 @@
+package edge
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	Register(&ExploitContainerdSock{}, RegisterDefault)
+}
+
+type ExploitContainerdSock struct {
+	BaseContainerEscape
+}
+
+func (e *ExploitContainerdSock) Label() string {
+	return "EXPLOIT_CONTAINERD_SOCK"
+}
+
+func (e *ExploitContainerdSock) Name() string {
+	return "ExploitContainerdSock"
+}
+
+// Processor delegates the processing tasks to the generic containerEscapeProcessor.
+func (e *ExploitContainerdSock) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	return containerEscapeProcessor(ctx, oic, e.Label(), entry)
+}
+
+const (
+	runDir       = "/run"
+	legacyRunDir = "/var/run" // Symlink to /run on all the mainstream distributions
+)
+
+// canonicalRunPath cleans the provided host path and resolves the /var/run prefix to /run.
+func canonicalRunPath(p string) string {
+	p = path.Clean(p)
+	if p == legacyRunDir || strings.HasPrefix(p, legacyRunDir+"/") {
+		return runDir + strings.TrimPrefix(p, legacyRunDir)
+	}
+
+	return p
+}
+
+// legacyRunPath returns the /var/run alias of a canonical host path under /run, if any.
+func legacyRunPath(p string) (string, bool) {
+	if p == runDir || strings.HasPrefix(p, runDir+"/") {
+		return legacyRunDir + strings.TrimPrefix(p, runDir), true
+	}
+
+	return "", false
+}
+
+// runtimeSocketMounts returns the host paths exposing one of the configured container runtime sockets when mounted into
+// a container, i.e the sockets themselves and all their parent directories. Paths under /var/run are canonicalised to
+// /run and matched under both prefixes: /var/run is a symlink to /run, so mounting /var does not expose the sockets
+// (the symlink is resolved within the container).
+func (e *ExploitContainerdSock) runtimeSocketMounts() bson.A {
+	seen := make(map[string]bool)
+	mounts := bson.A{}
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			mounts = append(mounts, p)
+		}
+	}
+
+	for _, socket := range e.cfg.RuntimeSockets {
+		for p := canonicalRunPath(socket); ; p = path.Dir(p) {
+			add(p)
+			if alias, ok := legacyRunPath(p); ok {
+				add(alias)
+			}
+
+			if p == "/" || p == "." {
+				break
+			}
+		}
+	}
+
+	return mounts
+}
+
+func (e *ExploitContainerdSock) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	volumes := adapter.MongoDB(store).Collection(collections.VolumeName)
+
+	// Escape is possible if a container runtime socket (or any parent directory) is mounted into the container, allowing
+	// to create privileged containers or execute commands in any container of the node. Sockets mounted as read-only can
+	// still be written to, so the read-only flag of the mount is not considered.
+	pipeline := bson.A{
+		bson.M{
+			"$match": bson.M{
+				"type": shared.VolumeTypeHost,
+				"source": bson.M{
+					"$in": e.runtimeSocketMounts(),
+				},
+			},
+		},
+		// A container can mount multiple runtime sockets, we just need a 1:1 mapping of the node and container
+		bson.M{
+			"$group": bson.M{
+				"_id":     "$container_id",
+				"node_id": bson.M{"$first": "$node_id"},
+			},
+		},
+	}
+
+	cur, err := volumes.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[containerEscapeGroup](ctx, cur, callback, complete)
+}
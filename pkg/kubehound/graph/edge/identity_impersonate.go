@@ -0,0 +1,170 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	IdentityImpersonateLabel = "IDENTITY_IMPERSONATE"
+)
+
+func init() {
+	Register(&IdentityImpersonate{}, RegisterDefault)
+}
+
+// IdentityImpersonate links cluster-wide permission sets granting the impersonate verb to the users, groups and
+// service accounts they can impersonate.
+type IdentityImpersonate struct {
+	BaseEdge
+}
+
+type identityImpersonateGroup struct {
+	Role     primitive.ObjectID `bson:"_id" json:"role"`
+	Identity primitive.ObjectID `bson:"identity_id" json:"identity"`
+}
+
+func (e *IdentityImpersonate) Label() string {
+	return IdentityImpersonateLabel
+}
+
+func (e *IdentityImpersonate) Name() string {
+	return "IdentityImpersonate"
+}
+
+func (e *IdentityImpersonate) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*identityImpersonateGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Identity)
+}
+
+// impersonateMatch returns a permission set filter (to be used within a permissions $elemMatch) matching permissions
+// that grant the impersonate verb on any of the provided identity types, whether restricted to resource names or not.
+func impersonateMatch(resources map[string][]groupResources) bson.M {
+	targets := bson.A{}
+	for _, r := range resources {
+		targets = append(targets, permissionTargets(r)...)
+	}
+
+	return bson.M{
+		"$or":  targets,
+		"verb": "impersonate",
+	}
+}
+
+// impersonateProjection returns the projection of a permission set flagging the identity types it can impersonate
+// entirely and collecting the names of the identities it can impersonate specifically.
+func impersonateProjection(resources map[string][]groupResources) bson.M {
+	projection := bson.M{
+		"_id":       1,
+		"namespace": 1,
+	}
+	for identityType, r := range resources {
+		projection["all"+identityType] = permissionExpr(r, "impersonate")
+		projection["names"+identityType] = permissionNamesExpr(r, "impersonate")
+	}
+
+	return projection
+}
+
+// impersonateLookupVars returns the variables to bind in the identity lookup from the fields of impersonateProjection.
+func impersonateLookupVars(resources map[string][]groupResources) bson.M {
+	vars := bson.M{}
+	for identityType := range resources {
+		vars["all"+identityType] = "$all" + identityType
+		vars["names"+identityType] = "$names" + identityType
+	}
+
+	return vars
+}
+
+// impersonateTargetExpr returns an aggregation expression evaluating whether the current identity can be impersonated
+// given the variables bound from impersonateProjection.
+func impersonateTargetExpr(resources map[string][]groupResources) bson.M {
+	targets := bson.A{}
+	for identityType := range resources {
+		targets = append(targets, bson.M{"$and": bson.A{
+			bson.M{"$eq": bson.A{"$type", identityType}},
+			bson.M{"$or": bson.A{
+				"$$all" + identityType,
+				bson.M{"$in": bson.A{"$name", "$$names" + identityType}},
+			}},
+		}})
+	}
+
+	return bson.M{"$or": targets}
+}
+
+// Stream finds all cluster-wide permission sets granting the impersonate verb on users, groups or service accounts
+// and the identities they can impersonate, restricted to the resource names of the permission if any.
+func (e *IdentityImpersonate) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	resources := map[string][]groupResources{
+		shared.IdentityTypeUser:  impersonateUserResources,
+		shared.IdentityTypeGroup: impersonateGroupResources,
+		shared.IdentityTypeSA:    impersonateSAResources,
+	}
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"is_namespaced": false,
+				"permissions": bson.M{
+					"$elemMatch": impersonateMatch(resources),
+				},
+			},
+		},
+		{
+			"$project": impersonateProjection(resources),
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "identities",
+				"from": collections.IdentityName,
+				"let":  impersonateLookupVars(resources),
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": impersonateTargetExpr(resources)},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$identities",
+		},
+		{
+			"$project": bson.M{
+				"_id":         1,
+				"identity_id": "$identities._id",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[identityImpersonateGroup](ctx, cur, callback, complete)
+}
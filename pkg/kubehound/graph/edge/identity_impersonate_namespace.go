@@ -0,0 +1,109 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	Register(&IdentityImpersonateNamespace{}, RegisterDefault)
+}
+
+// IdentityImpersonateNamespace links namespaced permission sets granting the impersonate verb to the service accounts
+// of their namespace. Users and groups are not namespaced, so impersonating them requires a cluster-wide binding.
+type IdentityImpersonateNamespace struct {
+	BaseEdge
+}
+
+func (e *IdentityImpersonateNamespace) Label() string {
+	return IdentityImpersonateLabel
+}
+
+func (e *IdentityImpersonateNamespace) Name() string {
+	return "IdentityImpersonateNamespace"
+}
+
+func (e *IdentityImpersonateNamespace) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*identityImpersonateGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Identity)
+}
+
+// Stream finds all namespaced permission sets granting the impersonate verb on service accounts and the service
+// accounts of the same namespace they can impersonate, restricted to the resource names of the permission if any.
+func (e *IdentityImpersonateNamespace) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	resources := map[string][]groupResources{
+		shared.IdentityTypeSA: impersonateSAResources,
+	}
+
+	lookupVars := impersonateLookupVars(resources)
+	lookupVars["roleNamespace"] = "$namespace"
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"is_namespaced": true,
+				"permissions": bson.M{
+					"$elemMatch": impersonateMatch(resources),
+				},
+			},
+		},
+		{
+			"$project": impersonateProjection(resources),
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "identities",
+				"from": collections.IdentityName,
+				"let":  lookupVars,
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$and": bson.A{
+								bson.M{"$eq": bson.A{"$namespace", "$$roleNamespace"}},
+								impersonateTargetExpr(resources),
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$identities",
+		},
+		{
+			"$project": bson.M{
+				"_id":         1,
+				"identity_id": "$identities._id",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[identityImpersonateGroup](ctx, cur, callback, complete)
+}
@@ -0,0 +1,113 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	IdentityMemberLabel = "IDENTITY_MEMBER"
+)
+
+func init() {
+	Register(&IdentityMember{}, RegisterDefault)
+}
+
+// IdentityMember links identities to the bound groups they are implicitly a member of (e.g system:authenticated or
+// system:serviceaccounts:<namespace>), so that permissions granted to these groups are reachable from the identity.
+type IdentityMember struct {
+	BaseEdge
+}
+
+type identityMemberGroup struct {
+	Identity primitive.ObjectID `bson:"_id" json:"identity"`
+	Group    primitive.ObjectID `bson:"group_id" json:"group"`
+}
+
+func (e *IdentityMember) Label() string {
+	return IdentityMemberLabel
+}
+
+func (e *IdentityMember) Name() string {
+	return "IdentityMember"
+}
+
+func (e *IdentityMember) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*identityMemberGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Identity, typed.Group)
+}
+
+// Stream finds all identities with implicit group memberships and matches them against the group identities created
+// from the role binding subjects. Unbound groups have no identity and therefore generate no edge.
+func (e *IdentityMember) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	identities := adapter.MongoDB(store).Collection(collections.IdentityName)
+	pipeline := bson.A{
+		bson.M{
+			"$match": bson.M{
+				"groups": bson.M{
+					"$exists": true,
+					"$ne":     bson.A{},
+				},
+			},
+		},
+		bson.M{
+			"$lookup": bson.M{
+				"as":   "group",
+				"from": collections.IdentityName,
+				"let": bson.M{
+					"groups": "$groups",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"type": shared.IdentityTypeGroup},
+							bson.M{"$expr": bson.M{
+								"$in": bson.A{
+									"$name", "$$groups",
+								},
+							}},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$unwind": "$group",
+		},
+		bson.M{
+			"$project": bson.M{
+				"_id":      1,
+				"group_id": "$group._id",
+			},
+		},
+	}
+
+	cur, err := identities.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[identityMemberGroup](ctx, cur, callback, complete)
+}
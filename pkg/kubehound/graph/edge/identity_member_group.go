@@ -0,0 +1,137 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&IdentityMemberGroup{}, RegisterDefault)
+}
+
+// IdentityMemberGroup links users to the explicit OpenShift groups (user.openshift.io) they are listed in.
+type IdentityMemberGroup struct {
+	BaseEdge
+}
+
+type identityMemberGroupGroup struct {
+	Group primitive.ObjectID `bson:"_id" json:"group"`
+	User  primitive.ObjectID `bson:"user_id" json:"user"`
+}
+
+func (e *IdentityMemberGroup) Label() string {
+	return IdentityMemberLabel
+}
+
+func (e *IdentityMemberGroup) Name() string {
+	return "IdentityMemberGroup"
+}
+
+func (e *IdentityMemberGroup) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*identityMemberGroupGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.User, typed.Group)
+}
+
+// Stream finds the group identity matching each collected OpenShift group along with the user identities of all its
+// members. Only groups bound to a role are collected into the store.
+func (e *IdentityMemberGroup) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	groups := adapter.MongoDB(store).Collection(collections.GroupName)
+	pipeline := bson.A{
+		bson.M{
+			"$match": bson.M{
+				"users": bson.M{
+					"$exists": true,
+					"$ne":     bson.A{},
+				},
+			},
+		},
+		bson.M{
+			"$lookup": bson.M{
+				"as":   "group",
+				"from": collections.IdentityName,
+				"let": bson.M{
+					"groupName": "$name",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"type": shared.IdentityTypeGroup},
+							bson.M{"$expr": bson.M{
+								"$eq": bson.A{
+									"$name", "$$groupName",
+								},
+							}},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$unwind": "$group",
+		},
+		bson.M{
+			"$lookup": bson.M{
+				"as":   "user",
+				"from": collections.IdentityName,
+				"let": bson.M{
+					"users": "$users",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"type": shared.IdentityTypeUser},
+							bson.M{"$expr": bson.M{
+								"$in": bson.A{
+									"$name", "$$users",
+								},
+							}},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		bson.M{
+			"$unwind": "$user",
+		},
+		bson.M{
+			"$project": bson.M{
+				"_id":     "$group._id",
+				"user_id": "$user._id",
+			},
+		},
+	}
+
+	cur, err := groups.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[identityMemberGroupGroup](ctx, cur, callback, complete)
+}
@@ -0,0 +1,172 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&IngressExpose{}, RegisterDefault)
+}
+
+// IngressExpose links an ingress or HTTP route to the endpoints of the EndpointSlices backing the service ports it
+// routes traffic to.
+type IngressExpose struct {
+	BaseEdge
+}
+
+type ingressEndpointGroup struct {
+	Ingress  primitive.ObjectID `bson:"ingress_id" json:"ingress_id"`
+	Endpoint primitive.ObjectID `bson:"endpoint_id" json:"endpoint_id"`
+}
+
+func (e *IngressExpose) Label() string {
+	return "INGRESS_EXPOSE"
+}
+
+func (e *IngressExpose) Name() string {
+	return "IngressExpose"
+}
+
+func (e *IngressExpose) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*ingressEndpointGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Ingress, typed.Endpoint)
+}
+
+func (e *IngressExpose) Stream(ctx context.Context, store storedb.Provider, c cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	ingresses := adapter.MongoDB(store).Collection(collections.IngressName)
+
+	// Ingress backends reference a service port either by number or by name, while the EndpointSlice ports backing the
+	// service only carry the name of the service port. Resolve the backend to the service port name first, then match
+	// the endpoints generated from the slices of the service on that port name.
+	pipeline := []bson.M{
+		{
+			"$unwind": "$backends",
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "servicePorts",
+				"from": collections.ServiceName,
+				"let": bson.M{
+					"ns":       "$backends.namespace",
+					"svc":      "$backends.service_name",
+					"port":     "$backends.port",
+					"portName": "$backends.port_name",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$and": bson.A{
+								bson.M{"$eq": bson.A{"$namespace", "$$ns"}},
+								bson.M{"$eq": bson.A{"$name", "$$svc"}},
+							},
+						}},
+					},
+					{
+						"$unwind": "$k8.spec.ports",
+					},
+					{
+						// A backend without any port (HTTP routes may omit it) matches all the service ports
+						"$match": bson.M{"$expr": bson.M{
+							"$or": bson.A{
+								bson.M{"$and": bson.A{
+									bson.M{"$eq": bson.A{"$$port", 0}},
+									bson.M{"$eq": bson.A{"$$portName", ""}},
+								}},
+								bson.M{"$and": bson.A{
+									bson.M{"$ne": bson.A{"$$port", 0}},
+									bson.M{"$eq": bson.A{"$k8.spec.ports.port", "$$port"}},
+								}},
+								bson.M{"$and": bson.A{
+									bson.M{"$ne": bson.A{"$$portName", ""}},
+									bson.M{"$eq": bson.A{"$k8.spec.ports.name", "$$portName"}},
+								}},
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id":       0,
+							"port_name": bson.M{"$ifNull": bson.A{"$k8.spec.ports.name", ""}},
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$servicePorts",
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "matchEndpoints",
+				"from": collections.EndpointName,
+				"let": bson.M{
+					"ns":       "$backends.namespace",
+					"svc":      "$backends.service_name",
+					"portName": "$servicePorts.port_name",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$and": bson.A{
+								bson.M{"$eq": bson.A{"$has_slice", true}},
+								bson.M{"$eq": bson.A{"$namespace", "$$ns"}},
+								bson.M{"$eq": bson.A{"$service_name", "$$svc"}},
+								bson.M{"$eq": bson.A{
+									bson.M{"$ifNull": bson.A{"$port.name", ""}}, "$$portName",
+								}},
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$matchEndpoints",
+		},
+		{
+			// Several backends of an ingress can resolve to the same endpoint
+			"$group": bson.M{
+				"_id": bson.M{
+					"ingress_id":  "$_id",
+					"endpoint_id": "$matchEndpoints._id",
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":         0,
+				"ingress_id":  "$_id.ingress_id",
+				"endpoint_id": "$_id.endpoint_id",
+			},
+		},
+	}
+
+	cur, err := ingresses.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[ingressEndpointGroup](ctx, cur, callback, complete)
+}
@@ -0,0 +1,261 @@
+package edge
+
+import (
+	"strings"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// groupResources holds a set of resources of a single API group.
+type groupResources struct {
+	group     string
+	resources []string
+}
+
+var (
+	// podCreateResources holds the resources whose creation spawns new pods: pods themselves and the workload
+	// controllers managing pods from a pod template.
+	podCreateResources = []groupResources{
+		{group: "", resources: []string{"pods", "replicationcontrollers"}},
+		{group: "apps", resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"}},
+		{group: "batch", resources: []string{"cronjobs", "jobs"}},
+	}
+
+	// podPatchResources holds the resources whose modification changes the code running in existing pods. The pod
+	// template of jobs is immutable, so jobs are excluded (unlike cron jobs which spawn new jobs from their template).
+	podPatchResources = []groupResources{
+		{group: "", resources: []string{"pods", "replicationcontrollers"}},
+		{group: "apps", resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"}},
+		{group: "batch", resources: []string{"cronjobs"}},
+	}
+
+	// podPatchNamespaceResources holds the resources whose modification changes the code running in any existing pod of
+	// a namespace. Replication controllers are not collected, so the pods they manage cannot be resolved and all pods of
+	// the namespace are considered. The collected workload controllers are handled by workloadPatchResources instead.
+	podPatchNamespaceResources = []groupResources{
+		{group: "", resources: []string{"pods", "replicationcontrollers"}},
+	}
+
+	// podPatchNamedResources holds the resources whose specifically named objects are the patched pods themselves. The
+	// pods managed by named replication controllers cannot be resolved as replication controllers are not collected.
+	podPatchNamedResources = []groupResources{
+		{group: "", resources: []string{"pods"}},
+	}
+
+	// workloadPatchResources holds the collected workload controllers whose modification changes the code running in the
+	// pods they manage.
+	workloadPatchResources = []groupResources{
+		{group: "apps", resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"}},
+		{group: "batch", resources: []string{"cronjobs"}},
+	}
+
+	// webhookWriteResources holds the admission webhook configurations whose modification allows to inject arbitrary
+	// containers in the pods created on the cluster. Validating webhooks can only reject objects and are excluded.
+	webhookWriteResources = []groupResources{
+		{group: "admissionregistration.k8s.io", resources: []string{"mutatingwebhookconfigurations"}},
+	}
+
+	podExecResources = []groupResources{
+		{group: "", resources: []string{"pods/exec"}},
+	}
+
+	podLogResources = []groupResources{
+		{group: "", resources: []string{"pods/log"}},
+	}
+
+	secretResources = []groupResources{
+		{group: "", resources: []string{"secrets"}},
+	}
+
+	// impersonateUserResources, impersonateGroupResources and impersonateSAResources hold the identities that can be
+	// impersonated via the impersonate verb.
+	impersonateUserResources = []groupResources{
+		{group: "", resources: []string{"users"}},
+	}
+	impersonateGroupResources = []groupResources{
+		{group: "", resources: []string{"groups"}},
+	}
+	impersonateSAResources = []groupResources{
+		{group: "", resources: []string{"serviceaccounts"}},
+	}
+
+	// roleBindingResources and clusterRoleBindingResources hold the bindings that can be created to grant a role.
+	roleBindingResources = []groupResources{
+		{group: "rbac.authorization.k8s.io", resources: []string{"rolebindings"}},
+	}
+	clusterRoleBindingResources = []groupResources{
+		{group: "rbac.authorization.k8s.io", resources: []string{"clusterrolebindings", "rolebindings"}},
+	}
+	// clusterRoleBindingOnlyResources holds the bindings granting a cluster role cluster-wide.
+	clusterRoleBindingOnlyResources = []groupResources{
+		{group: "rbac.authorization.k8s.io", resources: []string{"clusterrolebindings"}},
+	}
+
+	// roleResources and clusterRoleResources hold the roles that can be bound via the bind verb.
+	roleResources = []groupResources{
+		{group: "rbac.authorization.k8s.io", resources: []string{"roles"}},
+	}
+	clusterRoleResources = []groupResources{
+		{group: "rbac.authorization.k8s.io", resources: []string{"clusterroles"}},
+	}
+)
+
+// permissionTargets returns the filters matching the provided resources (or subresources, e.g pods/exec) of a permission.
+func permissionTargets(resources []groupResources) bson.A {
+	targets := bson.A{}
+	for _, gr := range resources {
+		for _, r := range gr.resources {
+			resource, subresource, _ := strings.Cut(r, "/")
+			targets = append(targets, bson.M{
+				"group":       gr.group,
+				"resource":    resource,
+				"subresource": subresource,
+			})
+		}
+	}
+
+	return targets
+}
+
+// permissionMatch returns a permission set filter (to be used within a permissions $elemMatch) matching permissions
+// that grant any of the provided verbs on any of the provided resources (or subresources, e.g pods/exec) for all the
+// objects of the resource.
+func permissionMatch(resources []groupResources, verbs ...string) bson.M {
+	return bson.M{
+		"$or":            permissionTargets(resources),
+		"verb":           bson.M{"$in": verbs},
+		"resource_names": nil,
+	}
+}
+
+// permissionNamedMatch returns a permission set filter (to be used within a permissions $elemMatch) matching
+// permissions that grant any of the provided verbs on any of the provided resources for specifically named objects only.
+func permissionNamedMatch(resources []groupResources, verbs ...string) bson.M {
+	return bson.M{
+		"$or":            permissionTargets(resources),
+		"verb":           bson.M{"$in": verbs},
+		"resource_names": bson.M{"$ne": nil},
+	}
+}
+
+// permissionCondExpr returns an aggregation expression evaluating whether the permission bound to the $$perm variable
+// grants any of the provided verbs on any of the provided resources.
+func permissionCondExpr(resources []groupResources, verbs ...string) bson.M {
+	targets := bson.A{}
+	for _, gr := range resources {
+		for _, r := range gr.resources {
+			resource, subresource, _ := strings.Cut(r, "/")
+			targets = append(targets, bson.M{"$and": bson.A{
+				bson.M{"$eq": bson.A{"$$perm.group", gr.group}},
+				bson.M{"$eq": bson.A{"$$perm.resource", resource}},
+				bson.M{"$eq": bson.A{"$$perm.subresource", subresource}},
+			}})
+		}
+	}
+
+	return bson.M{"$and": bson.A{
+		bson.M{"$in": bson.A{"$$perm.verb", verbs}},
+		bson.M{"$or": targets},
+	}}
+}
+
+// permissionExpr returns an aggregation expression evaluating whether any of the permissions of the current permission
+// set grants any of the provided verbs on any of the provided resources for all the objects of the resource.
+func permissionExpr(resources []groupResources, verbs ...string) bson.M {
+	return bson.M{
+		"$anyElementTrue": bson.A{
+			bson.M{"$map": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$permissions", bson.A{}}},
+				"as":    "perm",
+				"in": bson.M{"$and": bson.A{
+					permissionCondExpr(resources, verbs...),
+					bson.M{"$eq": bson.A{bson.M{"$size": bson.M{"$ifNull": bson.A{"$$perm.resource_names", bson.A{}}}}, 0}},
+				}},
+			}},
+		},
+	}
+}
+
+// permissionNamesExpr returns an aggregation expression collecting the resource names of all the permissions of the
+// current permission set that grant any of the provided verbs on any of the provided resources.
+func permissionNamesExpr(resources []groupResources, verbs ...string) bson.M {
+	return bson.M{
+		"$reduce": bson.M{
+			"input": bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$permissions", bson.A{}}},
+				"as":    "perm",
+				"cond":  permissionCondExpr(resources, verbs...),
+			}},
+			"initialValue": bson.A{},
+			"in": bson.M{"$setUnion": bson.A{
+				"$$value",
+				bson.M{"$ifNull": bson.A{"$$this.resource_names", bson.A{}}},
+			}},
+		},
+	}
+}
+
+// workloadPermissionExpr returns an aggregation expression evaluating whether any of the permissions (bound to the
+// $$permissions variable) grants any of the provided verbs on the workload controller of the current document, either
+// for all the objects of the resource or for the specific name of the controller.
+func workloadPermissionExpr(verbs ...string) bson.M {
+	return bson.M{
+		"$anyElementTrue": bson.A{
+			bson.M{"$map": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$$permissions", bson.A{}}},
+				"as":    "perm",
+				"in": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$$perm.group", "$group"}},
+					bson.M{"$eq": bson.A{"$$perm.resource", "$resource"}},
+					bson.M{"$eq": bson.A{"$$perm.subresource", ""}},
+					bson.M{"$in": bson.A{"$$perm.verb", verbs}},
+					bson.M{"$or": bson.A{
+						bson.M{"$eq": bson.A{bson.M{"$size": bson.M{"$ifNull": bson.A{"$$perm.resource_names", bson.A{}}}}, 0}},
+						bson.M{"$in": bson.A{"$name", bson.M{"$ifNull": bson.A{"$$perm.resource_names", bson.A{}}}}},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+// workloadPodsStages returns the aggregation stages resolving the pods managed by the workload controllers of the
+// current pipeline, directly or indirectly (e.g deployment pods via their replica sets) through the workload ownership
+// chain. Each output document holds the _id of a managed pod.
+func workloadPodsStages() []bson.M {
+	return []bson.M{
+		{
+			"$graphLookup": bson.M{
+				"from":             collections.WorkloadName,
+				"startWith":        "$uid",
+				"connectFromField": "uid",
+				"connectToField":   "owner_uid",
+				"as":               "managed",
+			},
+		},
+		{
+			"$project": bson.M{
+				"workloads": bson.M{
+					"$concatArrays": bson.A{bson.A{"$_id"}, "$managed._id"},
+				},
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":           "pods",
+				"from":         collections.PodName,
+				"localField":   "workloads",
+				"foreignField": "owner_id",
+			},
+		},
+		{
+			"$unwind": "$pods",
+		},
+		{
+			"$project": bson.M{
+				"_id": "$pods._id",
+			},
+		},
+	}
+}
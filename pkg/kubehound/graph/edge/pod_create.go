@@ -87,6 +87,7 @@ func (e *PodCreate) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("Node").
+				Has("cluster", e.cluster()).
 				Has("class", "Node").
 				As("n").
 				V(inserts...).
@@ -100,7 +101,8 @@ func (e *PodCreate) Traversal() types.EdgeTraversal {
 	}
 }
 
-// Stream finds all roles that have pod/create or equivalent wildcard permissions.
+// Stream finds all roles that have pod/create or equivalent wildcard permissions, excluding namespaced roles scoped to
+// namespaces where privileged pods are blocked.
 func (e *PodCreate) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
 	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
 
@@ -108,31 +110,27 @@ func (e *PodCreate) Stream(ctx context.Context, store storedb.Provider, _ cache.
 	pipeline := []bson.M{
 		{
 			"$match": bson.M{
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods"},
-								bson.M{"resources": "cronjobs"},
-								bson.M{"resources": "deamonsets"},
-								bson.M{"resources": "deployments"},
-								bson.M{"resources": "jobs"},
-								bson.M{"resources": "replicasets"},
-								bson.M{"resources": "replicationcontrollers"},
-								bson.M{"resources": "statefulsets"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "create"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(podCreateResources, "create"),
+				},
+			},
+		},
+		// Namespaced permission sets can only create pods in their own namespace. Escaping to the node requires a
+		// privileged pod, which is rejected by Pod Security Admission in namespaces enforcing the baseline or
+		// restricted levels. Namespaces missing from the store are assumed to allow privileged pods.
+		{
+			"$lookup": bson.M{
+				"as":           "podSecurity",
+				"from":         collections.NamespaceName,
+				"localField":   "namespace",
+				"foreignField": "name",
+			},
+		},
+		{
+			"$match": bson.M{
+				"$or": bson.A{
+					bson.M{"is_namespaced": false},
+					bson.M{"podSecurity.privileged_blocked": bson.M{"$ne": true}},
 				},
 			},
 		},
@@ -87,6 +87,7 @@ func (e *PodExec) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("Pod").
+				Has("cluster", e.cluster()).
 				Has("class", "Pod").
 				As("p").
 				V(inserts...).
@@ -109,25 +110,8 @@ func (e *PodExec) Stream(ctx context.Context, store storedb.Provider, _ cache.Ca
 		{
 			"$match": bson.M{
 				"is_namespaced": false,
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods/exec"},
-								bson.M{"resources": "pods/*"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "create"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(podExecResources, "create"),
 				},
 			},
 		},
@@ -0,0 +1,118 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&PodExecNamed{}, RegisterDefault)
+}
+
+// PodExecNamed handles the pod exec permissions restricted to specifically named pods via the
+// resourceNames of the RBAC rules. Unrestricted permissions are handled by PodExec and PodExecNamespace.
+type PodExecNamed struct {
+	BaseEdge
+}
+
+type podExecNamedGroup struct {
+	Role primitive.ObjectID `bson:"_id" json:"role"`
+	Pod  primitive.ObjectID `bson:"pod" json:"pod"`
+}
+
+func (e *PodExecNamed) Label() string {
+	return "POD_EXEC"
+}
+
+func (e *PodExecNamed) Name() string {
+	return "PodExecNamed"
+}
+
+func (e *PodExecNamed) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*podExecNamedGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Pod)
+}
+
+// Stream finds all roles that have pod/exec permissions restricted to specific pod names and the matching pods.
+// Matching pods are defined as the pods with one of the names that share the role namespace, or any namespace for non-namespaced roles.
+func (e *PodExecNamed) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"permissions": bson.M{
+					"$elemMatch": permissionNamedMatch(podExecResources, "create"),
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":           1,
+				"namespace":     1,
+				"is_namespaced": 1,
+				"names":         permissionNamesExpr(podExecResources, "create"),
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "namedPods",
+				"from": collections.PodName,
+				"let": bson.M{
+					"roleNamespace":    "$namespace",
+					"roleIsNamespaced": "$is_namespaced",
+					"names":            "$names",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$and": bson.A{
+								bson.M{"$in": bson.A{"$k8.objectmeta.name", "$$names"}},
+								bson.M{"$or": bson.A{
+									bson.M{"$eq": bson.A{"$$roleIsNamespaced", false}},
+									bson.M{"$eq": bson.A{"$k8.objectmeta.namespace", "$$roleNamespace"}},
+								}},
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$namedPods",
+		},
+		{
+			"$project": bson.M{
+				"_id": 1,
+				"pod": "$namedPods._id",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[podExecNamedGroup](ctx, cur, callback, complete)
+}
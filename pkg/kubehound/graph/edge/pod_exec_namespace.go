@@ -54,25 +54,8 @@ func (e *PodExecNamespace) Stream(ctx context.Context, store storedb.Provider, _
 		{
 			"$match": bson.M{
 				"is_namespaced": true,
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods/exec"},
-								bson.M{"resources": "pods/*"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "create"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(podExecResources, "create"),
 				},
 			},
 		},
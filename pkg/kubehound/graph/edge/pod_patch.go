@@ -87,6 +87,7 @@ func (e *PodPatch) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("Pod").
+				Has("cluster", e.cluster()).
 				Has("class", "Pod").
 				As("p").
 				V(inserts...).
@@ -100,7 +101,8 @@ func (e *PodPatch) Traversal() types.EdgeTraversal {
 	}
 }
 
-// Stream finds all roles that have pod/patch or equivalent wildcard permissions.
+// Stream finds all roles that have pod/patch or equivalent wildcard permissions, including patch permissions on the
+// workload controllers managing pods.
 func (e *PodPatch) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
 	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
 
@@ -109,32 +111,8 @@ func (e *PodPatch) Stream(ctx context.Context, store storedb.Provider, _ cache.C
 		{
 			"$match": bson.M{
 				"is_namespaced": false,
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods"},
-								bson.M{"resources": "cronjobs"},
-								bson.M{"resources": "deamonsets"},
-								bson.M{"resources": "deployments"},
-								bson.M{"resources": "jobs"},
-								bson.M{"resources": "replicasets"},
-								bson.M{"resources": "replicationcontrollers"},
-								bson.M{"resources": "statefulsets"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "patch"},
-								bson.M{"verbs": "update"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(podPatchResources, "patch", "update"),
 				},
 			},
 		},
@@ -0,0 +1,151 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&PodPatchNamed{}, RegisterDefault)
+}
+
+// PodPatchNamed handles the cluster wide pod patch permissions restricted to specifically named pods or workload
+// controllers via the resourceNames of the RBAC rules. Unrestricted cluster wide permissions are handled by PodPatch,
+// while namespaced permissions are handled by PodPatchNamespace and PodPatchWorkload.
+type PodPatchNamed struct {
+	BaseEdge
+}
+
+type podPatchNamedGroup struct {
+	Role primitive.ObjectID `bson:"_id" json:"role"`
+	Pod  primitive.ObjectID `bson:"pod" json:"pod"`
+}
+
+func (e *PodPatchNamed) Label() string {
+	return "POD_PATCH"
+}
+
+func (e *PodPatchNamed) Name() string {
+	return "PodPatchNamed"
+}
+
+func (e *PodPatchNamed) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*podPatchNamedGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Pod)
+}
+
+// Stream finds all non-namespaced roles that have pod/patch or equivalent wildcard permissions restricted to specific
+// names only, and the matching pods in any namespace: the named pods and the pods managed by the named workload
+// controllers (resolved through the workload ownership chain). Roles with unrestricted permissions already reach all
+// the pods of the cluster via PodPatch and are skipped.
+func (e *PodPatchNamed) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"is_namespaced": false,
+				"$and": bson.A{
+					bson.M{"permissions": bson.M{
+						"$elemMatch": permissionNamedMatch(podPatchResources, "patch", "update"),
+					}},
+					bson.M{"permissions": bson.M{
+						"$not": bson.M{"$elemMatch": permissionMatch(podPatchResources, "patch", "update")},
+					}},
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":         1,
+				"permissions": 1,
+				"podNames":    permissionNamesExpr(podPatchNamedResources, "patch", "update"),
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "namedPods",
+				"from": collections.PodName,
+				"let": bson.M{
+					"podNames": "$podNames",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$in": bson.A{"$k8.objectmeta.name", "$$podNames"},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "workloadPods",
+				"from": collections.WorkloadName,
+				"let": bson.M{
+					"permissions": "$permissions",
+				},
+				"pipeline": append([]bson.M{
+					{
+						"$match": bson.M{
+							"$expr": workloadPermissionExpr("patch", "update"),
+						},
+					},
+				}, workloadPodsStages()...),
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id": 1,
+				"pods": bson.M{
+					"$concatArrays": bson.A{"$namedPods", "$workloadPods"},
+				},
+			},
+		},
+		{
+			"$unwind": "$pods",
+		},
+		// The same pod can be both named and reached via multiple controllers of the ownership chain
+		{
+			"$group": bson.M{
+				"_id": bson.M{
+					"role": "$_id",
+					"pod":  "$pods._id",
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id": "$_id.role",
+				"pod": "$_id.pod",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[podPatchNamedGroup](ctx, cur, callback, complete)
+}
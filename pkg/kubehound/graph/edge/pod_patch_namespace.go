@@ -44,8 +44,10 @@ func (e *PodPatchNamespace) Processor(ctx context.Context, oic *converter.Object
 	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Pod)
 }
 
-// Stream finds all roles that are namespaced and have pod/exec or equivalent wildcard permissions and matching pods.
-// Matching pods are defined as all pods that share the role namespace or non-namespaced pods.
+// Stream finds all roles that are namespaced and have pod/patch or equivalent wildcard permissions and matching pods.
+// Matching pods are defined as all pods that share the role namespace or non-namespaced pods, restricted to the named
+// pods for permissions scoped to specific resource names. Patch permissions on workload controllers are handled by
+// PodPatchWorkload.
 func (e *PodPatchNamespace) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
 	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
 
@@ -54,51 +56,48 @@ func (e *PodPatchNamespace) Stream(ctx context.Context, store storedb.Provider,
 		{
 			"$match": bson.M{
 				"is_namespaced": true,
-				"rules": bson.M{
+				"permissions": bson.M{
 					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "pods"},
-								bson.M{"resources": "cronjobs"},
-								bson.M{"resources": "deamonsets"},
-								bson.M{"resources": "deployments"},
-								bson.M{"resources": "jobs"},
-								bson.M{"resources": "replicasets"},
-								bson.M{"resources": "replicationcontrollers"},
-								bson.M{"resources": "statefulsets"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "patch"},
-								bson.M{"verbs": "update"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
+						"$or":  permissionTargets(podPatchNamespaceResources),
+						"verb": bson.M{"$in": bson.A{"patch", "update"}},
 					},
 				},
 			},
 		},
+		{
+			"$project": bson.M{
+				"_id":       1,
+				"namespace": 1,
+				"allPods":   permissionExpr(podPatchNamespaceResources, "patch", "update"),
+				"podNames":  permissionNamesExpr(podPatchNamedResources, "patch", "update"),
+			},
+		},
 		{
 			"$lookup": bson.M{
 				"as":   "podsInNamespace",
 				"from": "pods",
 				"let": bson.M{
 					"roleNamespace": "$namespace",
+					"allPods":       "$allPods",
+					"podNames":      "$podNames",
 				},
 				"pipeline": []bson.M{
 					{
-						"$match": bson.M{"$or": bson.A{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"$or": bson.A{
+								bson.M{"$expr": bson.M{
+									"$eq": bson.A{
+										"$k8.objectmeta.namespace", "$$roleNamespace",
+									},
+								}},
+								bson.M{"is_namespaced": false},
+							}},
 							bson.M{"$expr": bson.M{
-								"$eq": bson.A{
-									"$k8.objectmeta.namespace", "$$roleNamespace",
+								"$or": bson.A{
+									"$$allPods",
+									bson.M{"$in": bson.A{"$k8.objectmeta.name", "$$podNames"}},
 								},
 							}},
-							bson.M{"is_namespaced": false},
 						}},
 					},
 					{
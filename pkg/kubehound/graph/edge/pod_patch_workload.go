@@ -0,0 +1,117 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&PodPatchWorkload{}, RegisterDefault)
+}
+
+type PodPatchWorkload struct {
+	BaseEdge
+}
+
+type podPatchWorkloadGroup struct {
+	Role primitive.ObjectID `bson:"_id" json:"role"`
+	Pod  primitive.ObjectID `bson:"pod" json:"pod"`
+}
+
+func (e *PodPatchWorkload) Label() string {
+	return "POD_PATCH"
+}
+
+func (e *PodPatchWorkload) Name() string {
+	return "PodPatchWorkload"
+}
+
+func (e *PodPatchWorkload) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*podPatchWorkloadGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Pod)
+}
+
+// Stream finds all roles that are namespaced and have patch or equivalent wildcard permissions on workload controllers
+// (e.g daemonsets/patch), for all the controllers or specifically named ones, and the pods managed by the matching
+// controllers of the role namespace. Patching the pod template of a controller rolls out new pods running the attacker
+// code in place of the existing ones. Pods managed indirectly (e.g deployment pods via their replica sets) are resolved
+// through the workload ownership chain.
+func (e *PodPatchWorkload) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"is_namespaced": true,
+				"permissions": bson.M{
+					"$elemMatch": bson.M{
+						"$or":  permissionTargets(workloadPatchResources),
+						"verb": bson.M{"$in": bson.A{"patch", "update"}},
+					},
+				},
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "workloadPods",
+				"from": collections.WorkloadName,
+				"let": bson.M{
+					"roleNamespace": "$namespace",
+					"permissions":   "$permissions",
+				},
+				"pipeline": append([]bson.M{
+					{
+						"$match": bson.M{
+							"$expr": bson.M{
+								"$and": bson.A{
+									bson.M{"$eq": bson.A{"$namespace", "$$roleNamespace"}},
+									workloadPermissionExpr("patch", "update"),
+								},
+							},
+						},
+					},
+				}, workloadPodsStages()...),
+			},
+		},
+		{
+			"$unwind": "$workloadPods",
+		},
+		// The same pod can be reached via multiple controllers of the ownership chain
+		{
+			"$group": bson.M{
+				"_id": bson.M{
+					"role": "$_id",
+					"pod":  "$workloadPods._id",
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id": "$_id.role",
+				"pod": "$_id.pod",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[podPatchWorkloadGroup](ctx, cur, callback, complete)
+}
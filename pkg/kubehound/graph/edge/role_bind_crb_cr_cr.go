@@ -63,6 +63,7 @@ func (e *RoleBindCrbCrCr) Traversal() types.EdgeTraversal {
 			// For larger clusters simply target specific roles to reduce number of attack paths
 			g.V().
 				HasLabel("PermissionSet").
+				Has("cluster", e.cluster()).
 				Has("isNamespaced", false).
 				// Temporary measure, until we scan and flag for sensitive roles
 				Has("role", P.Within(sensitiveRoles)).
@@ -76,6 +77,7 @@ func (e *RoleBindCrbCrCr) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("PermissionSet").
+				Has("cluster", e.cluster()).
 				Has("isNamespaced", false).
 				As("i").
 				V(inserts...).
@@ -101,68 +103,16 @@ func (e *RoleBindCrbCrCr) Stream(ctx context.Context, store storedb.Provider, c
 				// looking for CRB/CR role only
 				"is_namespaced": false,
 				"$and": []bson.M{
-					// Looking for RBAC objects
+					// Looking for creation of bindings
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$or": []bson.M{
-									{"apigroups": "*"},
-									{"apigroups": "rbac.authorization.k8s.io"},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(clusterRoleBindingResources, "create"),
 						},
 					},
-					// looking for creation of rolebindings / clusterrolebindings
+					// Looking for binding roles
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "create"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "clusterrolebindings"},
-											{"resources": "rolebindings"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
-						},
-					},
-					// Looking for binding clusterroles
-					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "bind"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "clusterroles"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(clusterRoleResources, "bind"),
 						},
 					},
 				},
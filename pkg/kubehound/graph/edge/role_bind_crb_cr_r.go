@@ -63,6 +63,7 @@ func (e *RoleBindCrbCrR) Traversal() types.EdgeTraversal {
 			// For larger clusters simply target specific roles to reduce number of attack paths
 			g.V().
 				HasLabel("PermissionSet").
+				Has("cluster", e.cluster()).
 				Has("isNamespaced", true).
 				// Temporary measure, until we scan and flag for sensitive roles
 				Has("role", P.Within(sensitiveRoles)).
@@ -76,6 +77,7 @@ func (e *RoleBindCrbCrR) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("PermissionSet").
+				Has("cluster", e.cluster()).
 				Has("isNamespaced", true).
 				As("i").
 				V(inserts...).
@@ -100,67 +102,16 @@ func (e *RoleBindCrbCrR) Stream(ctx context.Context, store storedb.Provider, c c
 			"$match": bson.M{
 				"is_namespaced": false,
 				"$and": []bson.M{
-					// Looking for RBAC objects
+					// Looking for creation of bindings
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$or": []bson.M{
-									{"apigroups": "*"},
-									{"apigroups": "rbac.authorization.k8s.io"},
-								},
-							},
-						},
-					},
-					// Looking for creation of rolebindings
-					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "create"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "rolebindings"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(roleBindingResources, "create"),
 						},
 					},
 					// Looking for binding roles
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "bind"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "roles"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(roleResources, "bind"),
 						},
 					},
 				},
@@ -0,0 +1,175 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&RoleBindNamed{}, RegisterDefault)
+}
+
+// RoleBindNamed handles the bind permissions restricted to specifically named roles or cluster roles via the
+// resourceNames of the RBAC rules. Unrestricted bind permissions are handled by the other ROLE_BIND edges.
+type RoleBindNamed struct {
+	BaseEdge
+}
+
+type roleBindNamedGroup struct {
+	Role   primitive.ObjectID `bson:"_id" json:"role"`
+	Target primitive.ObjectID `bson:"target" json:"target"`
+}
+
+func (e *RoleBindNamed) Label() string {
+	return RoleBindLabel
+}
+
+func (e *RoleBindNamed) Name() string {
+	return "RoleBindNamed"
+}
+
+func (e *RoleBindNamed) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*roleBindNamedGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Target)
+}
+
+// Stream finds all roles that can create bindings and bind specifically named roles, and the permission sets those
+// bindings would grant:
+//   - cluster roles named in the bind permission, bound cluster-wide by a clusterrolebinding
+//   - cluster roles or roles named in the bind permission, bound in the role namespace (or any namespace for
+//     non-namespaced roles) by a rolebinding
+func (e *RoleBindNamed) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"$and": bson.A{
+					bson.M{"permissions": bson.M{
+						"$elemMatch": permissionNamedMatch(append(roleResources, clusterRoleResources...), "bind"),
+					}},
+					bson.M{"permissions": bson.M{
+						"$elemMatch": permissionMatch(clusterRoleBindingResources, "create"),
+					}},
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":           1,
+				"namespace":     1,
+				"is_namespaced": 1,
+				"roleNames":     permissionNamesExpr(roleResources, "bind"),
+				"clusterNames":  permissionNamesExpr(clusterRoleResources, "bind"),
+				"canRB":         permissionExpr(roleBindingResources, "create"),
+				"canCRB": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$is_namespaced", false}},
+					permissionExpr(clusterRoleBindingOnlyResources, "create"),
+				}},
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "boundPermissionSets",
+				"from": collections.PermissionSetName,
+				"let": bson.M{
+					"sourceId":           "$_id",
+					"sourceNamespace":    "$namespace",
+					"sourceIsNamespaced": "$is_namespaced",
+					"roleNames":          "$roleNames",
+					"clusterNames":       "$clusterNames",
+					"canRB":              "$canRB",
+					"canCRB":             "$canCRB",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$and": bson.A{
+								bson.M{"$ne": bson.A{"$_id", "$$sourceId"}},
+								bson.M{"$in": bson.A{"$role_name", bson.M{"$setUnion": bson.A{"$$roleNames", "$$clusterNames"}}}},
+							},
+						}},
+					},
+					{
+						"$lookup": bson.M{
+							"as":           "role",
+							"from":         collections.RoleName,
+							"localField":   "role_id",
+							"foreignField": "_id",
+						},
+					},
+					{
+						"$unwind": "$role",
+					},
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$or": bson.A{
+								// Cluster role bound cluster-wide via a clusterrolebinding
+								bson.M{"$and": bson.A{
+									"$$canCRB",
+									bson.M{"$eq": bson.A{"$is_namespaced", false}},
+									bson.M{"$in": bson.A{"$role_name", "$$clusterNames"}},
+								}},
+								// Role or cluster role bound within a namespace via a rolebinding
+								bson.M{"$and": bson.A{
+									"$$canRB",
+									bson.M{"$eq": bson.A{"$is_namespaced", true}},
+									bson.M{"$or": bson.A{
+										bson.M{"$eq": bson.A{"$$sourceIsNamespaced", false}},
+										bson.M{"$eq": bson.A{"$namespace", "$$sourceNamespace"}},
+									}},
+									bson.M{"$or": bson.A{
+										bson.M{"$and": bson.A{
+											bson.M{"$eq": bson.A{"$role.is_namespaced", false}},
+											bson.M{"$in": bson.A{"$role_name", "$$clusterNames"}},
+										}},
+										bson.M{"$and": bson.A{
+											bson.M{"$eq": bson.A{"$role.is_namespaced", true}},
+											bson.M{"$in": bson.A{"$role_name", "$$roleNames"}},
+										}},
+									}},
+								}},
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$boundPermissionSets",
+		},
+		{
+			"$project": bson.M{
+				"_id":    1,
+				"target": "$boundPermissionSets._id",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[roleBindNamedGroup](ctx, cur, callback, complete)
+}
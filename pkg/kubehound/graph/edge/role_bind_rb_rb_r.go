@@ -58,67 +58,16 @@ func (e *RoleBindRbRbR) Stream(ctx context.Context, store storedb.Provider, c ca
 				// looking for RB CR/R role only
 				"is_namespaced": true,
 				"$and": []bson.M{
-					// Looking for RBAC objects
+					// Looking for creation of bindings
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$or": []bson.M{
-									{"apigroups": "*"},
-									{"apigroups": "rbac.authorization.k8s.io"},
-								},
-							},
-						},
-					},
-					// Looking for creation of rolebindings
-					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "create"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "rolebindings"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(roleBindingResources, "create"),
 						},
 					},
 					// Looking for binding roles
 					{
-						"rules": bson.M{
-							"$elemMatch": bson.M{
-								"$and": []bson.M{
-									{
-										"$or": []bson.M{
-											{"verbs": "bind"},
-											{"verbs": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resources": "roles"},
-											{"resources": "*"},
-										},
-									},
-									{
-										"$or": []bson.M{
-											{"resourcenames": nil},
-										},
-									},
-								},
-							},
+						"permissions": bson.M{
+							"$elemMatch": permissionMatch(roleResources, "bind"),
 						},
 					},
 				},
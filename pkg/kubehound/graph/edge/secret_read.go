@@ -0,0 +1,196 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&SecretRead{}, RegisterDefault)
+}
+
+// SecretRead links permission sets granting read access to secrets to the identities the collected token secrets
+// authenticate as (service account tokens, bootstrap tokens and kubeconfigs).
+type SecretRead struct {
+	BaseEdge
+}
+
+type secretReadGroup struct {
+	Role     primitive.ObjectID `bson:"_id" json:"role"`
+	Identity primitive.ObjectID `bson:"identity_id" json:"identity"`
+}
+
+func (e *SecretRead) Label() string {
+	return "SECRET_READ"
+}
+
+func (e *SecretRead) Name() string {
+	return "SecretRead"
+}
+
+func (e *SecretRead) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*secretReadGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Identity)
+}
+
+// Stream finds all permission sets granting the get or list verbs on secrets, the token secrets they can read (within
+// their namespace for namespaced permission sets, restricted to the resource names of the permission if any) and the
+// identities these tokens authenticate as. Bootstrap tokens and kubeconfig client certificates also grant the
+// membership of their groups.
+func (e *SecretRead) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"permissions": bson.M{
+					"$elemMatch": bson.M{
+						"$or":  permissionTargets(secretResources),
+						"verb": bson.M{"$in": bson.A{"get", "list"}},
+					},
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":           1,
+				"namespace":     1,
+				"is_namespaced": 1,
+				"allSecrets":    permissionExpr(secretResources, "get", "list"),
+				"secretNames":   permissionNamesExpr(secretResources, "get", "list"),
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "secrets",
+				"from": collections.SecretName,
+				"let": bson.M{
+					"roleNamespace":    "$namespace",
+					"roleIsNamespaced": "$is_namespaced",
+					"allSecrets":       "$allSecrets",
+					"secretNames":      "$secretNames",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$and": bson.A{
+							bson.M{"token_type": bson.M{"$in": bson.A{
+								shared.TokenTypeSA,
+								shared.TokenTypeBoostrap,
+								shared.TokenTypeKubeconfig,
+							}}},
+							bson.M{"$expr": bson.M{
+								"$and": bson.A{
+									bson.M{"$or": bson.A{
+										bson.M{"$eq": bson.A{"$$roleIsNamespaced", false}},
+										bson.M{"$eq": bson.A{"$namespace", "$$roleNamespace"}},
+									}},
+									bson.M{"$or": bson.A{
+										"$$allSecrets",
+										bson.M{"$in": bson.A{"$name", "$$secretNames"}},
+									}},
+								},
+							}},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id":                1,
+							"token_type":         1,
+							"identity":           1,
+							"identity_namespace": 1,
+							"groups":             1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$secrets",
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "identities",
+				"from": collections.IdentityName,
+				"let": bson.M{
+					"tokenType":         "$secrets.token_type",
+					"tokenIdentity":     "$secrets.identity",
+					"identityNamespace": "$secrets.identity_namespace",
+					"tokenGroups":       bson.M{"$ifNull": bson.A{"$secrets.groups", bson.A{}}},
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{"$expr": bson.M{
+							"$or": bson.A{
+								// Service account tokens authenticate as the service account of the secret namespace, or of
+								// the namespace of the token subject for kubeconfigs
+								bson.M{"$and": bson.A{
+									bson.M{"$eq": bson.A{"$$tokenType", shared.TokenTypeSA}},
+									bson.M{"$eq": bson.A{"$type", shared.IdentityTypeSA}},
+									bson.M{"$eq": bson.A{"$name", "$$tokenIdentity"}},
+									bson.M{"$eq": bson.A{"$namespace", "$$identityNamespace"}},
+								}},
+								// Bootstrap tokens and kubeconfig client certificates authenticate as a user
+								bson.M{"$and": bson.A{
+									bson.M{"$in": bson.A{"$$tokenType", bson.A{shared.TokenTypeBoostrap, shared.TokenTypeKubeconfig}}},
+									bson.M{"$eq": bson.A{"$type", shared.IdentityTypeUser}},
+									bson.M{"$eq": bson.A{"$name", "$$tokenIdentity"}},
+								}},
+								bson.M{"$and": bson.A{
+									bson.M{"$eq": bson.A{"$type", shared.IdentityTypeGroup}},
+									bson.M{"$in": bson.A{"$name", "$$tokenGroups"}},
+								}},
+							},
+						}},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$identities",
+		},
+		// Multiple secrets can authenticate as the same identity, we just need a 1:1 mapping of the permission set and
+		// identity to create this edge
+		{
+			"$group": bson.M{
+				"_id": bson.M{
+					"role":     "$_id",
+					"identity": "$identities._id",
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":         "$_id.role",
+				"identity_id": "$_id.identity",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[secretReadGroup](ctx, cur, callback, complete)
+}
@@ -65,6 +65,7 @@ func (e *TokenBruteforce) Traversal() types.EdgeTraversal {
 			// For larger clusters simply target the system:masters group to reduce redundant attack paths
 			g.V().
 				HasLabel("Identity").
+				Has("cluster", e.cluster()).
 				Has("name", "system:masters").
 				As("i").
 				V(inserts...).
@@ -76,6 +77,7 @@ func (e *TokenBruteforce) Traversal() types.EdgeTraversal {
 			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
 			g.V().
 				HasLabel("Identity").
+				Has("cluster", e.cluster()).
 				Has("class", "Identity").
 				As("i").
 				V(inserts...).
@@ -98,24 +100,8 @@ func (e *TokenBruteforce) Stream(ctx context.Context, store storedb.Provider, _
 		{
 			"$match": bson.M{
 				"is_namespaced": false,
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "secrets"},
-								bson.M{"resources": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"verbs": "get"},
-								bson.M{"verbs": "*"},
-							}},
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
+				"permissions": bson.M{
+					"$elemMatch": permissionMatch(secretResources, "get"),
 				},
 			},
 		},
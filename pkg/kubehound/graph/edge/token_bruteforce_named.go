@@ -0,0 +1,140 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// legacyTokenSecretInfix separates the service account name from the random suffix in the name of the token
+	// secrets generated by the token controller (e.g default-token-x7k2p).
+	legacyTokenSecretInfix = "-token-"
+)
+
+func init() {
+	Register(&TokenBruteforceNamed{}, RegisterDefault)
+}
+
+// TokenBruteforceNamed handles the secrets/get permissions restricted to specifically named secrets via the
+// resourceNames of the RBAC rules. Unrestricted permissions are handled by TokenBruteforce and TokenBruteforceNamespace.
+type TokenBruteforceNamed struct {
+	BaseEdge
+}
+
+type tokenBruteforceNamedGroup struct {
+	Role     primitive.ObjectID `bson:"_id" json:"role"`
+	Identity primitive.ObjectID `bson:"identity" json:"identity"`
+}
+
+func (e *TokenBruteforceNamed) Label() string {
+	return "TOKEN_BRUTEFORCE"
+}
+
+func (e *TokenBruteforceNamed) Name() string {
+	return "TokenBruteforceNamed"
+}
+
+func (e *TokenBruteforceNamed) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*tokenBruteforceNamedGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Role, typed.Identity)
+}
+
+// Stream finds all roles that have secrets/get permissions restricted to specific secret names and the service accounts
+// whose token secret is named. Token secrets are matched on the <service account>-token- prefix of the names generated
+// by the token controller, in the role namespace or any namespace for non-namespaced roles.
+func (e *TokenBruteforceNamed) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"permissions": bson.M{
+					"$elemMatch": permissionNamedMatch(secretResources, "get"),
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":           1,
+				"namespace":     1,
+				"is_namespaced": 1,
+				"names":         permissionNamesExpr(secretResources, "get"),
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "namedIds",
+				"from": collections.IdentityName,
+				"let": bson.M{
+					"roleNamespace":    "$namespace",
+					"roleIsNamespaced": "$is_namespaced",
+					"names":            "$names",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{
+							"type": "ServiceAccount",
+							"$expr": bson.M{
+								"$and": bson.A{
+									bson.M{"$or": bson.A{
+										bson.M{"$eq": bson.A{"$$roleIsNamespaced", false}},
+										bson.M{"$eq": bson.A{"$namespace", "$$roleNamespace"}},
+									}},
+									bson.M{"$anyElementTrue": bson.A{
+										bson.M{"$map": bson.M{
+											"input": "$$names",
+											"as":    "secret",
+											"in": bson.M{"$eq": bson.A{
+												bson.M{"$indexOfBytes": bson.A{
+													"$$secret",
+													bson.M{"$concat": bson.A{"$name", legacyTokenSecretInfix}},
+												}},
+												0,
+											}},
+										}},
+									}},
+								},
+							},
+						},
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$unwind": "$namedIds",
+		},
+		{
+			"$project": bson.M{
+				"_id":      1,
+				"identity": "$namedIds._id",
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[tokenBruteforceNamedGroup](ctx, cur, callback, complete)
+}
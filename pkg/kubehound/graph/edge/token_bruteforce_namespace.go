@@ -49,39 +49,24 @@ func (e *TokenBruteforceNamespace) Processor(ctx context.Context, oic *converter
 func (e *TokenBruteforceNamespace) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
 	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
 
-	var verbMatcher bson.M
+	match := bson.M{
+		"is_namespaced": true,
+		"permissions": bson.M{
+			"$elemMatch": permissionMatch(secretResources, "get"),
+		},
+	}
+
 	if e.cfg.LargeClusterOptimizations {
 		// For large clusters do not create a redundant edge already covered by the TOKEN_LIST attack as this technique is much more complex
-		verbMatcher = bson.M{"verbs": "get"}
-	} else {
-		verbMatcher = bson.M{"$or": bson.A{
-			bson.M{"verbs": "get"},
-			bson.M{"verbs": "*"},
-		}}
+		match["$nor"] = bson.A{
+			bson.M{"permissions": bson.M{"$elemMatch": permissionMatch(secretResources, "list")}},
+		}
 	}
 
 	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
 	pipeline := []bson.M{
 		{
-			"$match": bson.M{
-				"is_namespaced": true,
-				"rules": bson.M{
-					"$elemMatch": bson.M{
-						"$and": bson.A{
-							bson.M{"$or": bson.A{
-								bson.M{"apigroups": ""},
-								bson.M{"apigroups": "*"},
-							}},
-							bson.M{"$or": bson.A{
-								bson.M{"resources": "secrets"},
-								bson.M{"resources": "*"},
-							}},
-							verbMatcher,
-							bson.M{"resourcenames": nil}, // TODO: handle resource scope
-						},
-					},
-				},
-			},
+			"$match": match,
 		},
 		{
 			"$lookup": bson.M{
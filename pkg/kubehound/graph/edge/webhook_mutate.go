@@ -0,0 +1,226 @@
+package edge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	Register(&WebhookMutate{}, RegisterDefault)
+}
+
+// WebhookMutate links the containers serving a mutating admission webhook to the pods the webhook can mutate, i.e the
+// pods whose namespace and labels match the selectors of a webhook intercepting pod creations or updates.
+type WebhookMutate struct {
+	BaseEdge
+}
+
+type webhookMutateGroup struct {
+	Container primitive.ObjectID `bson:"container" json:"container"`
+	Pod       primitive.ObjectID `bson:"pod" json:"pod"`
+}
+
+// webhookTarget holds a mutating webhook along with the containers serving it.
+type webhookTarget struct {
+	webhook    *store.Webhook
+	containers []primitive.ObjectID
+}
+
+// webhookPod holds the properties of a pod required to evaluate the selectors of the webhooks.
+type webhookPod struct {
+	Id primitive.ObjectID `bson:"_id"`
+	K8 struct {
+		ObjectMeta struct {
+			Namespace string            `bson:"namespace"`
+			Labels    map[string]string `bson:"labels"`
+		} `bson:"objectmeta"`
+	} `bson:"k8"`
+}
+
+func (e *WebhookMutate) Label() string {
+	return "WEBHOOK_MUTATE"
+}
+
+func (e *WebhookMutate) Name() string {
+	return "WebhookMutate"
+}
+
+func (e *WebhookMutate) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*webhookMutateGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	return adapter.GremlinEdgeProcessor(ctx, oic, e.Label(), typed.Container, typed.Pod)
+}
+
+// Stream finds the mutating webhooks intercepting pods and served by an in-cluster service, resolves the containers
+// backing the service and matches them to every pod selected by the namespace and object selectors of the webhook.
+func (e *WebhookMutate) Stream(ctx context.Context, sdb storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	db := adapter.MongoDB(sdb)
+	targets, err := webhookMutateTargets(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) == 0 {
+		return complete(ctx)
+	}
+
+	namespaces, err := webhookNamespaceLabels(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	projection := bson.M{"_id": 1, "k8.objectmeta.namespace": 1, "k8.objectmeta.labels": 1}
+	cur, err := db.Collection(collections.PodName).Find(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var pod webhookPod
+		if err := cur.Decode(&pod); err != nil {
+			return err
+		}
+
+		for _, t := range targets {
+			if !libkube.WebhookSelectorMatches(t.webhook.NamespaceSelector, namespaces[pod.K8.ObjectMeta.Namespace]) ||
+				!libkube.WebhookSelectorMatches(t.webhook.ObjectSelector, pod.K8.ObjectMeta.Labels) {
+				continue
+			}
+
+			for _, container := range t.containers {
+				err := callback(ctx, &webhookMutateGroup{
+					Container: container,
+					Pod:       pod.Id,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return complete(ctx)
+}
+
+// webhookMutateTargets returns the mutating webhooks intercepting pods along with the containers serving them.
+// Webhooks called via an URL or whose service is not backed by any known container are skipped.
+func webhookMutateTargets(ctx context.Context, db *mongo.Database) ([]webhookTarget, error) {
+	filter := bson.M{
+		"kind":         "MutatingWebhookConfiguration",
+		"targets_pods": true,
+		"service":      bson.M{"$ne": nil},
+	}
+
+	cur, err := db.Collection(collections.WebhookName).Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("loading mutating webhooks: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	targets := make([]webhookTarget, 0)
+	for cur.Next(ctx) {
+		webhook := &store.Webhook{}
+		if err := cur.Decode(webhook); err != nil {
+			return nil, fmt.Errorf("decoding mutating webhook: %w", err)
+		}
+
+		containers, err := webhookContainers(ctx, db, webhook.Service)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(containers) == 0 {
+			continue
+		}
+
+		targets = append(targets, webhookTarget{
+			webhook:    webhook,
+			containers: containers,
+		})
+	}
+
+	return targets, nil
+}
+
+// webhookContainers returns the containers exposing the endpoints of the service serving a webhook. The EndpointSlice
+// ports only carry the name of the service port, so the webhook service port is resolved to its name first. If the
+// service was not collected, all the endpoints of the service are considered.
+func webhookContainers(ctx context.Context, db *mongo.Database, svc *store.WebhookService) ([]primitive.ObjectID, error) {
+	filter := bson.M{
+		"namespace":    svc.Namespace,
+		"service_name": svc.Name,
+		"has_slice":    true,
+		"container_id": bson.M{"$ne": primitive.NilObjectID},
+	}
+
+	var service store.Service
+	err := db.Collection(collections.ServiceName).FindOne(ctx, bson.M{"namespace": svc.Namespace, "name": svc.Name}).Decode(&service)
+	switch {
+	case err == nil:
+		for _, p := range service.K8.Spec.Ports {
+			if p.Port == svc.Port {
+				filter["port.name"] = p.Name
+
+				break
+			}
+		}
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return nil, fmt.Errorf("loading webhook service %s/%s: %w", svc.Namespace, svc.Name, err)
+	}
+
+	values, err := db.Collection(collections.EndpointName).Distinct(ctx, "container_id", filter)
+	if err != nil {
+		return nil, fmt.Errorf("loading webhook service %s/%s containers: %w", svc.Namespace, svc.Name, err)
+	}
+
+	containers := make([]primitive.ObjectID, 0, len(values))
+	for _, v := range values {
+		if id, ok := v.(primitive.ObjectID); ok {
+			containers = append(containers, id)
+		}
+	}
+
+	return containers, nil
+}
+
+// webhookNamespaceLabels returns the labels of the collected namespaces keyed by namespace name.
+func webhookNamespaceLabels(ctx context.Context, db *mongo.Database) (map[string]map[string]string, error) {
+	projection := bson.M{"name": 1, "k8.objectmeta.labels": 1}
+	cur, err := db.Collection(collections.NamespaceName).Find(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, fmt.Errorf("loading namespaces: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	namespaces := make(map[string]map[string]string)
+	for cur.Next(ctx) {
+		var ns store.Namespace
+		if err := cur.Decode(&ns); err != nil {
+			return nil, fmt.Errorf("decoding namespace: %w", err)
+		}
+
+		namespaces[ns.Name] = ns.K8.Labels
+	}
+
+	return namespaces, nil
+}
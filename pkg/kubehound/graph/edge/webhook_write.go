@@ -0,0 +1,182 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/vertex"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	gremlin "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	Register(&WebhookWrite{}, RegisterGraphMutation)
+}
+
+// WebhookWrite links the permission sets allowed to create or modify mutating admission webhook configurations to the
+// nodes of the cluster. A mutating webhook can inject arbitrary (privileged) containers in any pod created on any node.
+type WebhookWrite struct {
+	BaseEdge
+}
+
+type webhookWriteGroup struct {
+	Role primitive.ObjectID `bson:"_id" json:"role"`
+}
+
+func (e *WebhookWrite) Label() string {
+	return "WEBHOOK_WRITE"
+}
+
+func (e *WebhookWrite) Name() string {
+	return "WebhookWrite"
+}
+
+func (e *WebhookWrite) BatchSize() int {
+	if e.cfg.LargeClusterOptimizations {
+		// Under optimization this becomes a very cheap operation
+		return e.cfg.BatchSize
+	}
+
+	return e.cfg.BatchSizeClusterImpact
+}
+
+func (e *WebhookWrite) Processor(ctx context.Context, oic *converter.ObjectIDConverter, entry any) (any, error) {
+	typed, ok := entry.(*webhookWriteGroup)
+	if !ok {
+		return nil, fmt.Errorf("invalid type passed to processor: %T", entry)
+	}
+
+	rid, err := oic.GraphID(ctx, typed.Role.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("%s edge role id convert: %w", e.Label(), err)
+	}
+
+	if e.cfg.LargeClusterOptimizations {
+		return map[any]any{
+			gremlin.T.Label: vertex.PermissionSetLabel,
+			gremlin.T.Id:    rid,
+		}, nil
+	}
+
+	return rid, nil
+}
+
+func (e *WebhookWrite) Traversal() types.EdgeTraversal {
+	return func(source *gremlin.GraphTraversalSource, inserts []any) *gremlin.GraphTraversal {
+		g := source.GetGraphTraversal()
+		if e.cfg.LargeClusterOptimizations {
+			// In large clusters this can explode the number of edges and we can safely assume this is a critical issue
+			g.
+				//nolint:asasalint // required due to constraints in the gremlin API
+				Inject(inserts).
+				Unfold().
+				As("rpc").
+				MergeV(__.Select("rpc")).
+				Option(gremlin.Merge.OnCreate, __.Fail("missing role vertex on WEBHOOK_WRITE insert")).
+				Option(gremlin.Merge.OnMatch, map[any]any{
+					"critical": true,
+				}).
+				AddE(e.Label()).
+				Barrier().Limit(0)
+		} else {
+			// In smaller clusters we can still show the (large set of) attack paths generated by this attack
+			g.V().
+				HasLabel("Node").
+				Has("cluster", e.cluster()).
+				Has("class", "Node").
+				As("n").
+				V(inserts...).
+				Has("critical", false).
+				AddE(e.Label()).
+				To("n").
+				Barrier().Limit(0)
+		}
+
+		return g
+	}
+}
+
+// Stream finds all cluster roles that have create, update or patch (or equivalent wildcard) permissions on mutating
+// webhook configurations. Webhook configurations are cluster scoped and cannot be granted by namespaced roles. Update
+// and patch permissions restricted to specific names only apply if one of the named configurations exists in the
+// cluster (create permissions cannot be restricted by name).
+func (e *WebhookWrite) Stream(ctx context.Context, store storedb.Provider, _ cache.CacheReader,
+	callback types.ProcessEntryCallback, complete types.CompleteQueryCallback) error {
+
+	permissionSets := adapter.MongoDB(store).Collection(collections.PermissionSetName)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"is_namespaced": false,
+				"permissions": bson.M{
+					"$elemMatch": bson.M{
+						"$or":  permissionTargets(webhookWriteResources),
+						"verb": bson.M{"$in": bson.A{"create", "update", "patch"}},
+					},
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":                1,
+				"allWebhooks":        permissionExpr(webhookWriteResources, "create", "update", "patch"),
+				"configurationNames": permissionNamesExpr(webhookWriteResources, "update", "patch"),
+			},
+		},
+		{
+			"$lookup": bson.M{
+				"as":   "namedWebhooks",
+				"from": collections.WebhookName,
+				"let": bson.M{
+					"configurationNames": "$configurationNames",
+				},
+				"pipeline": []bson.M{
+					{
+						"$match": bson.M{
+							"kind": "MutatingWebhookConfiguration",
+							"$expr": bson.M{
+								"$in": bson.A{"$configuration", "$$configurationNames"},
+							},
+						},
+					},
+					{
+						"$limit": 1,
+					},
+					{
+						"$project": bson.M{
+							"_id": 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			"$match": bson.M{
+				"$or": bson.A{
+					bson.M{"allWebhooks": true},
+					bson.M{"namedWebhooks.0": bson.M{"$exists": true}},
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id": 1,
+			},
+		},
+	}
+
+	cur, err := permissionSets.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	return adapter.MongoCursorHandler[webhookWriteGroup](ctx, cur, callback, complete)
+}
@@ -0,0 +1,29 @@
+package vertex
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/graph"
+)
+
+const (
+	IngressLabel = "Ingress"
+)
+
+var _ Builder = (*Ingress)(nil)
+
+type Ingress struct {
+	BaseVertex
+}
+
+func (v *Ingress) Label() string { return IngressLabel }
+
+func (v *Ingress) Processor(ctx context.Context, entry any) (any, error) {
+	return adapter.GremlinVertexProcessor[*graph.Ingress](ctx, entry)
+}
+
+func (v *Ingress) Traversal() types.VertexTraversal {
+	return v.DefaultTraversal(v.Label())
+}
@@ -0,0 +1,64 @@
+package vertex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/graph"
+	gremlingo "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngress_Traversal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want types.VertexTraversal
+		data graph.Ingress
+	}{
+		{
+			name: "Add Ingresses in JanusGraph",
+			// We set the values to all field with non default values
+			// so we are sure all are correctly propagated.
+			data: graph.Ingress{
+				StoreID:      "TestStoreID",
+				App:          "TestApp",
+				Team:         "TestTeam",
+				Service:      "TestService",
+				RunID:        "TestRunID",
+				Cluster:      "TestCluster",
+				IsNamespaced: true,
+				Namespace:    "TestNamespace",
+				Name:         "TestName",
+				Type:         "HTTPRoute",
+				IngressClass: "TestClass",
+				Hosts:        []string{"test.example.com"},
+				Gateways:     []string{"TestNamespace/TestGateway"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := Ingress{}
+
+			g := gremlingo.GraphTraversalSource{}
+
+			vertexTraversal := v.Traversal()
+			inserts := []any{&tt.data}
+
+			traversal := vertexTraversal(&g, inserts)
+			// This is ugly but doesn't need to write to the DB
+			// This just makes sure the traversal is correctly returned with the correct values
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestStoreID")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestName")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestClass")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "test.example.com")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestNamespace/TestGateway")
+		})
+	}
+}
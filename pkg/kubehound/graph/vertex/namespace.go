@@ -0,0 +1,31 @@
+package vertex
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/adapter"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/graph"
+)
+
+const (
+	NamespaceLabel = "Namespace"
+)
+
+var _ Builder = (*Namespace)(nil)
+
+type Namespace struct {
+	BaseVertex
+}
+
+func (v *Namespace) Label() string {
+	return NamespaceLabel
+}
+
+func (v *Namespace) Processor(ctx context.Context, entry any) (any, error) {
+	return adapter.GremlinVertexProcessor[*graph.Namespace](ctx, entry)
+}
+
+func (v *Namespace) Traversal() types.VertexTraversal {
+	return v.DefaultTraversal(v.Label())
+}
@@ -0,0 +1,60 @@
+package vertex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/graph"
+	gremlingo "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespace_Traversal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want types.VertexTraversal
+		data graph.Namespace
+	}{
+		{
+			name: "Add Namespaces in JanusGraph",
+			// We set the values to all field with non default values
+			// so we are sure all are correctly propagated.
+			data: graph.Namespace{
+				StoreID:            "TestStoreID",
+				App:                "TestApp",
+				Team:               "TestTeam",
+				Service:            "TestService",
+				RunID:              "TestRunID",
+				Cluster:            "TestCluster",
+				Name:               "TestName",
+				Labels:             []string{"TestLabel=TestValue"},
+				PodSecurityEnforce: "restricted",
+				PrivilegedBlocked:  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := Namespace{}
+
+			g := gremlingo.GraphTraversalSource{}
+
+			vertexTraversal := v.Traversal()
+			inserts := []any{&tt.data}
+
+			traversal := vertexTraversal(&g, inserts)
+			// This is ugly but doesn't need to write to the DB
+			// This just makes sure the traversal is correctly returned with the correct values
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestStoreID")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestName")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "TestLabel=TestValue")
+			assert.Contains(t, fmt.Sprintf("%s", traversal.Bytecode), "restricted")
+		})
+	}
+}
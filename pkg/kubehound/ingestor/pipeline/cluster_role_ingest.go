@@ -5,8 +5,10 @@ import (
 
 	"github.com/DataDog/KubeHound/pkg/globals/types"
 	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
 	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 const (
@@ -16,6 +18,7 @@ const (
 type ClusterRoleIngest struct {
 	collection collections.Role
 	r          *IngestResources
+	roles      []*rbacv1.ClusterRole // cluster roles buffered until the aggregation rules can be resolved
 }
 
 var _ ObjectIngest = (*ClusterRoleIngest)(nil)
@@ -40,12 +43,23 @@ func (i *ClusterRoleIngest) Initialize(ctx context.Context, deps *Dependencies)
 }
 
 // streamCallback is invoked by the collector for each cluster role collected.
-// The function ingests an input cluster role into the cache/store/graph databases asynchronously.
-func (i *ClusterRoleIngest) IngestClusterRole(ctx context.Context, role types.ClusterRoleType) error {
+// The function buffers an input cluster role as the aggregation rules can only be resolved once all the cluster roles
+// have been collected.
+func (i *ClusterRoleIngest) IngestClusterRole(_ context.Context, role types.ClusterRoleType) error {
 	if ok, err := preflight.CheckClusterRole(role); !ok {
 		return err
 	}
 
+	i.roles = append(i.roles, role)
+
+	return nil
+}
+
+// ingestClusterRole ingests an input cluster role into the cache/store/graph databases asynchronously, replacing the
+// collected rules of aggregated cluster roles with their effective rules.
+func (i *ClusterRoleIngest) ingestClusterRole(ctx context.Context, role types.ClusterRoleType,
+	aggregated map[string]libkube.AggregatedClusterRole) error {
+
 	// Normalize K8s cluster role to store object format. Cluster roles are treated as
 	// role within our model (with IsNamespaced flag set to false).
 	o, err := i.r.storeConvert.ClusterRole(ctx, role)
@@ -53,6 +67,11 @@ func (i *ClusterRoleIngest) IngestClusterRole(ctx context.Context, role types.Cl
 		return err
 	}
 
+	if agg, ok := aggregated[o.Name]; ok {
+		o.Rules = agg.Rules
+		o.Aggregated = agg.Sources
+	}
+
 	// Async write to store
 	if err := i.r.writeStore(ctx, i.collection, o); err != nil {
 		return err
@@ -63,8 +82,17 @@ func (i *ClusterRoleIngest) IngestClusterRole(ctx context.Context, role types.Cl
 }
 
 // completeCallback is invoked by the collector when all cluster roles have been streamed.
-// The function flushes all writers and waits for completion.
+// The function resolves the aggregation rules, ingests all the buffered cluster roles, flushes all writers and waits
+// for completion.
 func (i *ClusterRoleIngest) Complete(ctx context.Context) error {
+	aggregated := libkube.AggregateClusterRoles(i.roles)
+	for _, role := range i.roles {
+		if err := i.ingestClusterRole(ctx, role, aggregated); err != nil {
+			return err
+		}
+	}
+	i.roles = nil
+
 	return i.r.flushWriters(ctx)
 }
 
@@ -16,6 +16,8 @@ import (
 	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestClusterRoleIngest_Pipeline(t *testing.T) {
@@ -89,3 +91,83 @@ func TestClusterRoleIngest_Pipeline(t *testing.T) {
 	err = cri.Close(ctx)
 	assert.NoError(t, err)
 }
+
+func TestClusterRoleIngest_Aggregation(t *testing.T) {
+	t.Parallel()
+
+	cri := &ClusterRoleIngest{}
+
+	ctx := context.Background()
+	fakeRole, err := loadTestObject[types.ClusterRoleType]("testdata/clusterrole.json")
+	assert.NoError(t, err)
+
+	// Aggregated cluster role selecting the test cluster role, with stale rules
+	aggRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-aggregate"},
+		AggregationRule: &rbacv1.AggregationRule{
+			ClusterRoleSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"team": "test-team"}},
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+		},
+	}
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamClusterRoles(ctx, cri).
+		RunAndReturn(func(ctx context.Context, i collector.ClusterRoleIngestor) error {
+			for _, r := range []types.ClusterRoleType{aggRole, fakeRole} {
+				if err := i.IngestClusterRole(ctx, r); err != nil {
+					return err
+				}
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := cache.NewCacheProvider(t)
+	cw := cache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, cachekey.Role("test-reader", ""), mock.AnythingOfType("store.Role")).Return(nil).Once()
+	cw.EXPECT().Queue(ctx, cachekey.Role("test-aggregate", ""), mock.MatchedBy(func(r store.Role) bool {
+		return assert.ObjectsAreEqual(fakeRole.Rules, r.Rules) &&
+			assert.ObjectsAreEqual([]string{"test-reader"}, r.Aggregated)
+	})).Return(nil).Once()
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx).Return(cw, nil)
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	roles := collections.Role{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Role")).Return(nil).Twice()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, roles, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Builder: config.BuilderConfig{
+				Edge: config.EdgeBuilderConfig{},
+			},
+			Dynamic: config.DynamicConfig{
+				RunID:   config.NewRunID(),
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	err = cri.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	err = cri.Run(ctx)
+	assert.NoError(t, err)
+
+	err = cri.Close(ctx)
+	assert.NoError(t, err)
+}
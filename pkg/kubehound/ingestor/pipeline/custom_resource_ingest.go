@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	CustomResourceIngestName = "k8s-custom-resource-ingest"
+)
+
+type CustomResourceIngest struct {
+	collection collections.CustomResource
+	r          *IngestResources
+}
+
+var _ ObjectIngest = (*CustomResourceIngest)(nil)
+
+func (i *CustomResourceIngest) Name() string {
+	return CustomResourceIngestName
+}
+
+func (i *CustomResourceIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.collection = collections.CustomResource{}
+
+	i.r, err = CreateResources(ctx, deps,
+		WithStoreWriter(i.collection))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IngestCustomResource is invoked by the collector for each object of the configured custom resources.
+// The function ingests an input custom resource object into the store database asynchronously.
+func (i *CustomResourceIngest) IngestCustomResource(ctx context.Context, gvr schema.GroupVersionResource,
+	cr types.CustomResourceType) error {
+
+	if ok, err := preflight.CheckCustomResource(cr); !ok {
+		return err
+	}
+
+	// Normalize custom resource to store object format
+	o, err := i.r.storeConvert.CustomResource(ctx, gvr, cr)
+	if err != nil {
+		return err
+	}
+
+	// Async write to store. Custom resources are queried directly from the store when building the edges.
+	return i.r.writeStore(ctx, i.collection, o)
+}
+
+// Complete is invoked by the collector when all custom resource objects have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *CustomResourceIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *CustomResourceIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamCustomResources(ctx, i)
+}
+
+func (i *CustomResourceIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
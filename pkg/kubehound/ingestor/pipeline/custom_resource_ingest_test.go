@@ -0,0 +1,90 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCustomResourceIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	ci := &CustomResourceIngest{}
+
+	ctx := context.Background()
+	fakeApp, err := loadTestObject[types.CustomResourceType]("testdata/customresource.json")
+	assert.NoError(t, err)
+
+	gvr := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "applications"}
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamCustomResources(ctx, ci).
+		RunAndReturn(func(ctx context.Context, i collector.CustomResourceIngestor) error {
+			// Fake the stream of a single custom resource object from the collector client
+			err := i.IngestCustomResource(ctx, gvr, fakeApp)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	crs := collections.CustomResource{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.CustomResource")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			cr := i.(*store.CustomResource)
+			assert.Equal(t, "argoproj.io", cr.Group)
+			assert.Equal(t, "v1alpha1", cr.Version)
+			assert.Equal(t, "applications", cr.Resource)
+			assert.Equal(t, "Application", cr.Kind)
+			assert.Equal(t, "guestbook", cr.Name)
+			assert.Equal(t, "argocd", cr.Namespace)
+			assert.Equal(t, "5a3c1b2e-8f4d-4c6a-9e7b-1d2f3a4b5c6d", cr.UID)
+			assert.Equal(t, "ApplicationSet", cr.OwnerKind)
+			assert.Equal(t, "guestbook-set", cr.OwnerName)
+			assert.Equal(t, "0b9e8d7c-6a5f-4e3d-2c1b-a0f9e8d7c6b5", cr.OwnerUID)
+			assert.Equal(t, "platform", cr.Ownership.Team)
+			assert.Contains(t, cr.K8, "spec")
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, crs, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = ci.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = ci.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = ci.Close(ctx)
+	assert.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"github.com/DataDog/KubeHound/pkg/collector"
 	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
@@ -15,4 +16,5 @@ type Dependencies struct {
 	Cache     cache.CacheProvider
 	StoreDB   storedb.Provider
 	GraphDB   graphdb.Provider
+	Discovery *libkube.APIDiscovery // API discovery of the cluster, used to resolve RBAC wildcards
 }
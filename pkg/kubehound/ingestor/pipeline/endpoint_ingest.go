@@ -38,6 +38,7 @@ func (i *EndpointIngest) Initialize(ctx context.Context, deps *Dependencies) err
 
 	i.r, err = CreateResources(ctx, deps,
 		WithCacheWriter(cache.WithExpectedOverwrite()),
+		WithConverterCache(),
 		WithStoreWriter(i.collection),
 		WithGraphWriter(i.vertex))
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 	"github.com/DataDog/KubeHound/pkg/globals/types"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
 	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
 	graphdb "github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb/mocks"
 	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
@@ -47,6 +49,10 @@ func TestEndpointSlice_Pipeline(t *testing.T) {
 	cw.EXPECT().Flush(ctx).Return(nil)
 	cw.EXPECT().Close(ctx).Return(nil)
 	c.EXPECT().BulkWriter(ctx, mock.AnythingOfType("cache.WriterOption")).Return(cw, nil)
+	c.EXPECT().Get(ctx, cachekey.Service("cassandra-temporal-dev", "cassandra-temporal-dev")).Return(&cache.CacheResult{
+		Value: int64(shared.EndpointExposureNodeIP),
+		Err:   nil,
+	}).Times(2)
 
 	// Store setup
 	sdb := storedb.NewProvider(t)
@@ -70,7 +76,7 @@ func TestEndpointSlice_Pipeline(t *testing.T) {
 		"addresses":       []interface{}{"10.1.1.1"},
 		"app":             "cassandra",
 		"compromised":     float64(0),
-		"exposure":        float64(shared.EndpointExposureExternal),
+		"exposure":        float64(shared.EndpointExposureNodeIP),
 		"isNamespaced":    true,
 		"name":            "cassandra-temporal-dev-kmwfp::TCP::cql",
 		"namespace":       "cassandra-temporal-dev",
@@ -90,7 +96,7 @@ func TestEndpointSlice_Pipeline(t *testing.T) {
 		"addresses":       []interface{}{"10.1.1.1"},
 		"app":             "cassandra",
 		"compromised":     float64(0),
-		"exposure":        float64(shared.EndpointExposureExternal),
+		"exposure":        float64(shared.EndpointExposureNodeIP),
 		"isNamespaced":    true,
 		"name":            "cassandra-temporal-dev-kmwfp::TCP::jmx",
 		"namespace":       "cassandra-temporal-dev",
@@ -0,0 +1,160 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/vertex"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+)
+
+const (
+	GroupIngestName = "openshift-group-ingest"
+)
+
+type GroupIngest struct {
+	vertexIdentity *vertex.Identity
+	group          collections.Group
+	identity       collections.Identity
+	r              *openshiftIngressResources
+}
+
+var _ ObjectIngest = (*GroupIngest)(nil)
+
+func (i *GroupIngest) Name() string {
+	return GroupIngestName
+}
+
+func (i *GroupIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.vertexIdentity = &vertex.Identity{}
+	i.group = collections.Group{}
+	i.identity = collections.Identity{}
+
+	resources, err := CreateResources(ctx, deps,
+		WithCacheReader(),
+		WithCacheWriter(cache.WithTest()),
+		WithConverterCache(),
+		WithStoreWriter(i.group),
+		WithStoreWriter(i.identity),
+		WithGraphWriter(i.vertexIdentity))
+	if err != nil {
+		return err
+	}
+
+	openshiftCollector, ok := deps.Collector.(collector.OpenShiftCollectorClient)
+	if !ok {
+		return fmt.Errorf("incorrect collector type expected OpenShiftCollectorClient")
+	}
+
+	i.r = &openshiftIngressResources{
+		resources,
+		openshiftCollector,
+	}
+
+	return nil
+}
+
+// processMember will handle the ingestion pipeline for a user belonging to a processed OpenShift group input. Users are
+// only known through role binding subjects, so group members with no direct binding are created as new identities.
+// As with role binding subjects, this can create duplicate entries so lookup in cache before writing to the store.
+func (i *GroupIngest) processMember(ctx context.Context, user string, parent *store.Group) error {
+	// Normalize the group member to store identity object format
+	sid, err := i.r.storeConvert.GroupMember(ctx, user, parent)
+	if err != nil {
+		return err
+	}
+
+	// Async write to cache. If entry is already present skip further processing.
+	ck := cachekey.Identity(sid.Name, sid.Namespace)
+	err = i.r.writeCache(ctx, ck, sid.Id.Hex())
+	if err != nil {
+		var errOverwrite *cache.OverwriteError
+		if errors.As(err, &errOverwrite) {
+			log.Trace(ctx).Debugf("identity cache entry %#v already exists, skipping inserts", ck)
+
+			return nil
+		}
+
+		return err
+	}
+
+	// Async write identity to store
+	if err := i.r.writeStore(ctx, i.identity, sid); err != nil {
+		return err
+	}
+
+	// Transform store model to vertex input
+	insert, err := i.r.graphConvert.Identity(sid)
+	if err != nil {
+		return err
+	}
+
+	// Aysnc write to graph
+	return i.r.writeVertex(ctx, i.vertexIdentity, insert)
+}
+
+// IngestGroup is invoked by the collector for each OpenShift group collected.
+// The function ingests an input group object into the store and the identities of its members into the store/graph.
+// Groups not bound to any role grant no permission and are skipped.
+func (i *GroupIngest) IngestGroup(ctx context.Context, g types.GroupType) error {
+	if ok, err := preflight.CheckGroup(g); !ok {
+		return err
+	}
+
+	_, err := i.r.readCache(ctx, cachekey.Identity(g.Name, "")).ObjectID()
+	switch {
+	case err == nil:
+		// The group is bound to a role, continue
+	case errors.Is(err, cache.ErrNoEntry):
+		log.Trace(ctx).Debugf("OpenShift group %s not bound to any role, skipping ingest", g.Name)
+
+		return nil
+	default:
+		return err
+	}
+
+	// Normalize group to store object format
+	o, err := i.r.storeConvert.Group(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	// Async write to store
+	if err := i.r.writeStore(ctx, i.group, o); err != nil {
+		return err
+	}
+
+	// Group itself has no graph component (the group identity is created from the role binding subjects). However, the
+	// group members must be processed and included in the store & graph as identity objects/vertices.
+	for _, user := range o.Users {
+		if err := i.processMember(ctx, user, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Complete is invoked by the collector when all groups have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *GroupIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *GroupIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamGroups(ctx, i)
+}
+
+func (i *GroupIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
@@ -0,0 +1,131 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
+	graphdb "github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb/mocks"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	gi := &GroupIngest{}
+
+	ctx := context.Background()
+	fakeGroup, err := loadTestObject[types.GroupType]("testdata/group.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewOpenShiftCollectorClient(t)
+	client.EXPECT().StreamGroups(ctx, gi).
+		RunAndReturn(func(ctx context.Context, i collector.GroupIngestor) error {
+			// Fake the stream of a single group from the collector client
+			err := i.IngestGroup(ctx, fakeGroup)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := mockcache.NewCacheProvider(t)
+	c.EXPECT().Get(ctx, cachekey.Identity("app-admins", "")).Return(&cache.CacheResult{
+		Value: store.ObjectID().Hex(),
+		Err:   nil,
+	}).Once()
+	c.EXPECT().Get(ctx, cachekey.Identity("alice", "")).Return(&cache.CacheResult{
+		Value: nil,
+		Err:   cache.ErrNoEntry,
+	}).Once()
+	cw := mockcache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, mock.AnythingOfType("*cachekey.identityCacheKey"), mock.AnythingOfType("string")).Return(nil).Once()
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx, mock.AnythingOfType("cache.WriterOption")).Return(cw, nil)
+
+	// Store setup - groups
+	sdb := storedb.NewProvider(t)
+	gsw := storedb.NewAsyncWriter(t)
+	groups := collections.Group{}
+	gsw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Group")).Return(nil).Once()
+	gsw.EXPECT().Flush(ctx).Return(nil)
+	gsw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, groups, mock.Anything).Return(gsw, nil)
+
+	// Store setup - identities
+	isw := storedb.NewAsyncWriter(t)
+	identities := collections.Identity{}
+	storeID := store.ObjectID()
+	isw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Identity")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			i.(*store.Identity).Id = storeID
+
+			return nil
+		}).Once()
+	isw.EXPECT().Flush(ctx).Return(nil)
+	isw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, identities, mock.Anything).Return(isw, nil)
+
+	// Graph setup
+	vtxInsert := map[string]any{
+		"critical":     false,
+		"isNamespaced": false,
+		"name":         "alice",
+		"namespace":    "",
+		"storeID":      storeID.Hex(),
+		"type":         "User",
+		"team":         "test-team",
+		"app":          "test-app",
+		"service":      "test-service",
+		"cluster":      "test-cluster",
+		"runID":        testID.String(),
+	}
+	gdb := graphdb.NewProvider(t)
+	gw := graphdb.NewAsyncVertexWriter(t)
+	gw.EXPECT().Queue(ctx, vtxInsert).Return(nil).Once()
+	gw.EXPECT().Flush(ctx).Return(nil)
+	gw.EXPECT().Close(ctx).Return(nil)
+	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Identity"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(gw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		GraphDB:   gdb,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Builder: config.BuilderConfig{
+				Edge: config.EdgeBuilderConfig{},
+			},
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = gi.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = gi.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = gi.Close(ctx)
+	assert.NoError(t, err)
+}
@@ -71,7 +71,7 @@ func WithCacheReader() IngestResourceOption {
 // WithConverterCache initializes a store converter with cache access for the ingest pipeline.
 func WithConverterCache() IngestResourceOption {
 	return func(_ context.Context, rOpts *resourceOptions, deps *Dependencies) error {
-		rOpts.storeConvert = converter.NewStoreWithCache(deps.Config, deps.Cache)
+		rOpts.storeConvert = converter.NewStoreWithCache(deps.Config, deps.Cache).WithDiscovery(deps.Discovery)
 
 		return nil
 	}
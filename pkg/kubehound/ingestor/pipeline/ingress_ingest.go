@@ -0,0 +1,157 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/vertex"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	IngressIngestName = "k8s-ingress-ingest"
+)
+
+type IngressIngest struct {
+	vertex      *vertex.Ingress
+	collection  collections.Ingress
+	gatewayColl collections.Gateway
+	gateways    map[string]*store.Gateway // Gateways streamed so far keyed by namespace/name
+	r           *IngestResources
+}
+
+var _ ObjectIngest = (*IngressIngest)(nil)
+
+func (i *IngressIngest) Name() string {
+	return IngressIngestName
+}
+
+func (i *IngressIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.vertex = &vertex.Ingress{}
+	i.collection = collections.Ingress{}
+	i.gatewayColl = collections.Gateway{}
+	i.gateways = make(map[string]*store.Gateway)
+
+	i.r, err = CreateResources(ctx, deps,
+		WithStoreWriter(i.collection),
+		WithStoreWriter(i.gatewayColl),
+		WithGraphWriter(i.vertex))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ingestEntryPoint writes a normalized ingress store object to the store and graph databases asynchronously.
+func (i *IngressIngest) ingestEntryPoint(ctx context.Context, o *store.Ingress) error {
+	// Async write to store
+	if err := i.r.writeStore(ctx, i.collection, o); err != nil {
+		return err
+	}
+
+	// Transform store model to vertex input
+	insert, err := i.r.graphConvert.Ingress(o)
+	if err != nil {
+		return err
+	}
+
+	// Async write to graph
+	return i.r.writeVertex(ctx, i.vertex, insert)
+}
+
+// IngestIngress is invoked by the collector for each ingress collected.
+// The function ingests an input ingress into the store/graph databases asynchronously.
+func (i *IngressIngest) IngestIngress(ctx context.Context, ing types.IngressType) error {
+	if ok, err := preflight.CheckIngress(ing); !ok {
+		return err
+	}
+
+	// Normalize ingress to store object format
+	o, err := i.r.storeConvert.Ingress(ctx, ing)
+	if err != nil {
+		return err
+	}
+
+	return i.ingestEntryPoint(ctx, o)
+}
+
+// IngestGateway is invoked by the collector for each Gateway API gateway collected.
+// The function ingests an input gateway into the store database asynchronously and retains it to resolve the
+// hostnames of the HTTP routes attached to it.
+func (i *IngressIngest) IngestGateway(ctx context.Context, gw types.GatewayType) error {
+	if ok, err := preflight.CheckGateway(gw); !ok {
+		return err
+	}
+
+	// Normalize gateway to store object format
+	o, err := i.r.storeConvert.Gateway(ctx, gw)
+	if err != nil {
+		return err
+	}
+
+	i.gateways[fmt.Sprintf("%s/%s", o.Namespace, o.Name)] = o
+
+	// Async write to store
+	return i.r.writeStore(ctx, i.gatewayColl, o)
+}
+
+// IngestHTTPRoute is invoked by the collector for each Gateway API HTTP route collected.
+// The function ingests an input HTTP route into the store/graph databases asynchronously.
+func (i *IngressIngest) IngestHTTPRoute(ctx context.Context, route types.HTTPRouteType) error {
+	if ok, err := preflight.CheckHTTPRoute(route); !ok {
+		return err
+	}
+
+	// Normalize HTTP route to store object format
+	o, err := i.r.storeConvert.HTTPRoute(ctx, route)
+	if err != nil {
+		return err
+	}
+
+	// Routes without hostnames match the hostnames of the listeners of their parent gateways. Parent gateways are
+	// always streamed before the routes.
+	inherit := len(o.Hosts) == 0
+	for _, ref := range o.Gateways {
+		gw, ok := i.gateways[ref]
+		if !ok {
+			continue
+		}
+
+		if len(o.Class) == 0 {
+			o.Class = gw.K8.Spec.GatewayClassName
+		}
+
+		if !inherit {
+			continue
+		}
+
+		for _, l := range gw.K8.Spec.Listeners {
+			if l.Hostname != nil && !slices.Contains(o.Hosts, *l.Hostname) {
+				o.Hosts = append(o.Hosts, *l.Hostname)
+			}
+		}
+	}
+
+	return i.ingestEntryPoint(ctx, o)
+}
+
+// Complete is invoked by the collector when all ingresses, gateways and HTTP routes have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *IngressIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *IngressIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamIngresses(ctx, i)
+}
+
+func (i *IngressIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
@@ -0,0 +1,169 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
+	graphdb "github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb/mocks"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIngressIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	ii := &IngressIngest{}
+
+	ctx := context.Background()
+	fakeIngress, err := loadTestObject[types.IngressType]("testdata/ingress.json")
+	assert.NoError(t, err)
+	fakeGateway, err := loadTestObject[types.GatewayType]("testdata/gateway.json")
+	assert.NoError(t, err)
+	fakeRoute, err := loadTestObject[types.HTTPRouteType]("testdata/httproute.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamIngresses(ctx, ii).
+		RunAndReturn(func(ctx context.Context, i collector.IngressIngestor) error {
+			// Fake the stream of an ingress, a gateway and an HTTP route attached to it from the collector client
+			err := i.IngestIngress(ctx, fakeIngress)
+			if err != nil {
+				return err
+			}
+
+			err = i.IngestGateway(ctx, fakeGateway)
+			if err != nil {
+				return err
+			}
+
+			err = i.IngestHTTPRoute(ctx, fakeRoute)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := mockcache.NewCacheProvider(t)
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	ingresses := collections.Ingress{}
+	ingressID := store.ObjectID()
+	routeID := store.ObjectID()
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Ingress")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			ing := i.(*store.Ingress)
+			assert.Equal(t, store.IngressTypeIngress, ing.Type)
+			assert.Equal(t, "nginx", ing.Class)
+			assert.Equal(t, []string{"cassandra.example.com"}, ing.Hosts)
+			assert.Equal(t, []store.IngressBackend{
+				{Namespace: "cassandra-temporal-dev", ServiceName: "cassandra-temporal-dev", PortName: "cql"},
+				{Namespace: "cassandra-temporal-dev", ServiceName: "cassandra-admin", Port: 8080},
+			}, ing.Backends)
+			ing.Id = ingressID
+
+			return nil
+		}).Once()
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Ingress")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			route := i.(*store.Ingress)
+			assert.Equal(t, store.IngressTypeHTTPRoute, route.Type)
+			assert.Equal(t, "public-lb", route.Class)
+			assert.Equal(t, []string{"*.example.com"}, route.Hosts)
+			assert.Equal(t, []string{"gateways/external"}, route.Gateways)
+			assert.Equal(t, []store.IngressBackend{
+				{Namespace: "temporal", ServiceName: "temporal-web", Port: 8088},
+			}, route.Backends)
+			route.Id = routeID
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, ingresses, mock.Anything).Return(sw, nil)
+
+	gsw := storedb.NewAsyncWriter(t)
+	gateways := collections.Gateway{}
+	gsw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Gateway")).Return(nil).Once()
+	gsw.EXPECT().Flush(ctx).Return(nil)
+	gsw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, gateways, mock.Anything).Return(gsw, nil)
+
+	// Graph setup
+	vtxIngress := map[string]any{
+		"name":         "cassandra-temporal-dev",
+		"namespace":    "cassandra-temporal-dev",
+		"isNamespaced": true,
+		"type":         "Ingress",
+		"ingressClass": "nginx",
+		"hosts":        []any{"cassandra.example.com"},
+		"gateways":     []any{},
+		"storeID":      ingressID.Hex(),
+		"app":          "cassandra",
+		"service":      "",
+		"team":         "workflow-engine",
+		"cluster":      "test-cluster",
+		"runID":        testID.String(),
+	}
+	vtxRoute := map[string]any{
+		"name":         "temporal-web",
+		"namespace":    "temporal",
+		"isNamespaced": true,
+		"type":         "HTTPRoute",
+		"ingressClass": "public-lb",
+		"hosts":        []any{"*.example.com"},
+		"gateways":     []any{"gateways/external"},
+		"storeID":      routeID.Hex(),
+		"app":          "temporal",
+		"service":      "",
+		"team":         "workflow-engine",
+		"cluster":      "test-cluster",
+		"runID":        testID.String(),
+	}
+	gdb := graphdb.NewProvider(t)
+	gw := graphdb.NewAsyncVertexWriter(t)
+	gw.EXPECT().Queue(ctx, vtxIngress).Return(nil).Once()
+	gw.EXPECT().Queue(ctx, vtxRoute).Return(nil).Once()
+	gw.EXPECT().Flush(ctx).Return(nil)
+	gw.EXPECT().Close(ctx).Return(nil)
+	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Ingress"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(gw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		GraphDB:   gdb,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Builder: config.BuilderConfig{
+				Edge: config.EdgeBuilderConfig{},
+			},
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = ii.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = ii.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = ii.Close(ctx)
+	assert.NoError(t, err)
+}
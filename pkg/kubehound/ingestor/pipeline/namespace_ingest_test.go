@@ -0,0 +1,113 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
+	graphdb "github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb/mocks"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNamespaceIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	ni := &NamespaceIngest{}
+
+	ctx := context.Background()
+	fakeNamespace, err := loadTestObject[types.NamespaceType]("testdata/namespace.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamNamespaces(ctx, ni).
+		RunAndReturn(func(ctx context.Context, i collector.NamespaceIngestor) error {
+			// Fake the stream of a single namespace from the collector client
+			err := i.IngestNamespace(ctx, fakeNamespace)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := mockcache.NewCacheProvider(t)
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	namespaces := collections.Namespace{}
+	storeID := store.ObjectID()
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Namespace")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			ns := i.(*store.Namespace)
+			assert.Equal(t, "baseline", ns.EnforceLevel)
+			assert.True(t, ns.PrivilegedBlocked)
+			ns.Id = storeID
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, namespaces, mock.Anything).Return(sw, nil)
+
+	// Graph setup
+	vtxInsert := map[string]any{
+		"name": "test-app",
+		"labels": []any{
+			"kubernetes.io/metadata.name=test-app",
+			"pod-security.kubernetes.io/enforce=baseline",
+			"team=test-team",
+		},
+		"podSecurityEnforce": "baseline",
+		"privilegedBlocked":  true,
+		"storeID":            storeID.Hex(),
+		"app":                "",
+		"service":            "",
+		"team":               "test-team",
+		"cluster":            "test-cluster",
+		"runID":              testID.String(),
+	}
+	gdb := graphdb.NewProvider(t)
+	gw := graphdb.NewAsyncVertexWriter(t)
+	gw.EXPECT().Queue(ctx, vtxInsert).Return(nil).Once()
+	gw.EXPECT().Flush(ctx).Return(nil)
+	gw.EXPECT().Close(ctx).Return(nil)
+	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Namespace"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(gw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		GraphDB:   gdb,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Builder: config.BuilderConfig{
+				Edge: config.EdgeBuilderConfig{},
+			},
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = ni.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = ni.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = ni.Close(ctx)
+	assert.NoError(t, err)
+}
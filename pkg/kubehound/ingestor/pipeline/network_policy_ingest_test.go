@@ -0,0 +1,79 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNetworkPolicyIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	ni := &NetworkPolicyIngest{}
+
+	ctx := context.Background()
+	fakePolicy, err := loadTestObject[types.NetworkPolicyType]("testdata/networkpolicy.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamNetworkPolicies(ctx, ni).
+		RunAndReturn(func(ctx context.Context, i collector.NetworkPolicyIngestor) error {
+			// Fake the stream of a single network policy from the collector client
+			err := i.IngestNetworkPolicy(ctx, fakePolicy)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	policies := collections.NetworkPolicy{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.NetworkPolicy")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			policy := i.(*store.NetworkPolicy)
+			assert.Equal(t, "cassandra-ingress", policy.Name)
+			assert.Equal(t, "cassandra-temporal-dev", policy.Namespace)
+			assert.Len(t, policy.K8.Spec.Ingress, 1)
+			assert.Equal(t, "workflow-engine", policy.Ownership.Team)
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, policies, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = ni.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = ni.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = ni.Close(ctx)
+	assert.NoError(t, err)
+}
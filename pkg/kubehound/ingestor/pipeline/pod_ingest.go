@@ -7,6 +7,8 @@ import (
 	"github.com/DataDog/KubeHound/pkg/globals/types"
 	"github.com/DataDog/KubeHound/pkg/kubehound/graph/vertex"
 	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
@@ -26,6 +28,7 @@ const (
 	containerIndex
 	volumeIndex
 	endpointIndex
+	identityIndex
 	maxObjectIndex
 )
 
@@ -45,8 +48,9 @@ func (i *PodIngest) Initialize(ctx context.Context, deps *Dependencies) error {
 	var err error
 
 	//
-	// Pods will create other objects such as volumes (from the pod volume mount list) and containers
-	// from the (container/init container lists). As such we need to initialize a list of the writers we need.
+	// Pods will create other objects such as volumes (from the pod volume mount list), containers
+	// from the (container/init container/ephemeral container lists) and service account identities. As such we
+	// need to initialize a list of the writers we need.
 	//
 
 	i.v = []vertex.Builder{
@@ -54,6 +58,7 @@ func (i *PodIngest) Initialize(ctx context.Context, deps *Dependencies) error {
 		&vertex.Container{},
 		&vertex.Volume{},
 		&vertex.Endpoint{},
+		&vertex.Identity{},
 	}
 
 	i.c = []collections.Collection{
@@ -61,6 +66,7 @@ func (i *PodIngest) Initialize(ctx context.Context, deps *Dependencies) error {
 		collections.Container{},
 		collections.Volume{},
 		collections.Endpoint{},
+		collections.Identity{},
 	}
 
 	opts := make([]IngestResourceOption, 0)
@@ -80,6 +86,79 @@ func (i *PodIngest) Initialize(ctx context.Context, deps *Dependencies) error {
 	return nil
 }
 
+// processServiceAccount will handle the ingestion pipeline for the service account identity of a processed K8s pod input.
+// Identities are created from role binding subjects, so service accounts with no direct binding have no identity. However
+// service accounts are implicitly members of groups (e.g system:serviceaccounts) that may be granted permissions. We create
+// the identity of such service accounts if any of their implicit groups is bound, so the permissions of the groups are
+// reachable from the pod containers.
+func (i *PodIngest) processServiceAccount(ctx context.Context, pod *store.Pod) error {
+	if pod.K8.Spec.ServiceAccountName == "" {
+		return nil
+	}
+
+	ck := cachekey.Identity(pod.K8.Spec.ServiceAccountName, pod.K8.Namespace)
+	_, err := i.r.readCache(ctx, ck).ObjectID()
+	switch {
+	case err == nil:
+		// Identity already created from a role binding subject, nothing further to do
+		return nil
+	case errors.Is(err, cache.ErrNoEntry):
+		// No direct binding, check the implicit groups below
+	default:
+		return err
+	}
+
+	// Normalize the pod service account to store identity object format
+	sid, err := i.r.storeConvert.ServiceAccountIdentity(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	bound := false
+	for _, group := range sid.Groups {
+		_, err := i.r.readCache(ctx, cachekey.Identity(group, "")).ObjectID()
+		switch {
+		case err == nil:
+			bound = true
+		case errors.Is(err, cache.ErrNoEntry):
+			// Group not bound to any role
+		default:
+			return err
+		}
+	}
+
+	if !bound {
+		return nil
+	}
+
+	// Async write to cache. If entry is already present (service account shared by multiple pods) skip further processing.
+	err = i.r.writeCache(ctx, ck, sid.Id.Hex())
+	if err != nil {
+		var errOverwrite *cache.OverwriteError
+		if errors.As(err, &errOverwrite) {
+			log.Trace(ctx).Debugf("identity cache entry %#v already exists, skipping inserts", ck)
+
+			return nil
+		}
+
+		return err
+	}
+
+	// Async write identity to store
+	if err := i.r.writeStore(ctx, i.c[identityIndex], sid); err != nil {
+		return err
+	}
+
+	// Transform store model to vertex input
+	insert, err := i.r.graphConvert.Identity(sid)
+	if err != nil {
+		return err
+	}
+
+	// Aysnc write to graph
+	return i.r.writeVertex(ctx, i.v[identityIndex], insert)
+}
+
 // processEndpoints will handle the ingestion pipeline for a endpoints belonging to a processed K8s pod input.
 func (i *PodIngest) processEndpoints(ctx context.Context, port *corev1.ContainerPort, pod *store.Pod, container *store.Container) error {
 	// Normalize endpoint to temporary store object format
@@ -131,13 +210,15 @@ func (i *PodIngest) processEndpoints(ctx context.Context, port *corev1.Container
 }
 
 // processContainer will handle the ingestion pipeline for a container belonging to a processed K8s pod input.
-func (i *PodIngest) processContainer(ctx context.Context, parent *store.Pod, container types.ContainerType) error {
+func (i *PodIngest) processContainer(ctx context.Context, parent *store.Pod, container types.ContainerType,
+	containerType string) error {
+
 	if ok, err := preflight.CheckContainer(container); !ok {
 		return err
 	}
 
 	// Normalize container to store object format
-	sc, err := i.r.storeConvert.Container(ctx, container, parent)
+	sc, err := i.r.storeConvert.Container(ctx, container, containerType, parent)
 	if err != nil {
 		return err
 	}
@@ -247,18 +328,39 @@ func (i *PodIngest) IngestPod(ctx context.Context, pod types.PodType) error {
 		return err
 	}
 
+	// Handle the service account identity
+	if err := i.processServiceAccount(ctx, sp); err != nil {
+		return err
+	}
+
 	// Handle containers
 	for _, container := range pod.Spec.Containers {
 		c := container
-		err := i.processContainer(ctx, sp, &c)
+		err := i.processContainer(ctx, sp, &c, shared.ContainerTypeRegular)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Currently do not process init containers. Any interesting identity they are running under will be the same as the container since
-	// service accounts are defined at a pod level (although this may change in future K8s releases). Any interesting properties of the
-	// container will be ephemeral, making any exploitation very complex. Thus we do not include init containers within our graph.
+	// Handle init containers. Native sidecars (init containers with an Always restart policy) run for the whole lifetime
+	// of the pod and regular init containers are run again on every pod restart, so both are included in our graph.
+	for _, container := range pod.Spec.InitContainers {
+		c := container
+		err := i.processContainer(ctx, sp, &c, libkube.InitContainerType(&c))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Handle ephemeral containers. These cannot be removed from a pod once added and debug containers are often left
+	// running with elevated privileges.
+	for _, container := range pod.Spec.EphemeralContainers {
+		c := corev1.Container(container.EphemeralContainerCommon)
+		err := i.processContainer(ctx, sp, &c, shared.ContainerTypeEphemeral)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -9,15 +9,18 @@ import (
 	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/graph/vertex"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
 	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
 	graphdb "github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb/mocks"
 	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
 	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestPodIngest_Pipeline(t *testing.T) {
@@ -121,6 +124,13 @@ func TestPodIngest_Pipeline(t *testing.T) {
 	sdb.EXPECT().BulkWriter(ctx, volumes, mock.Anything).Return(vsw, nil)
 	sdb.EXPECT().BulkWriter(ctx, endpoints, mock.Anything).Return(esw, nil)
 
+	// Store setup - identities (the pod service account identity is already cached)
+	isw := storedb.NewAsyncWriter(t)
+	identities := collections.Identity{}
+	isw.EXPECT().Flush(ctx).Return(nil)
+	isw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, identities, mock.Anything).Return(isw, nil)
+
 	// Graph setup - pods
 	pv := map[string]any{
 		"compromised":           float64(0),
@@ -147,29 +157,30 @@ func TestPodIngest_Pipeline(t *testing.T) {
 
 	// Graph setup - containers
 	cv := map[string]any{
-		"args":         any(nil),
-		"isNamespaced": true,
-		"namespace":    "test-app",
-		"capabilities": []any{},
-		"command":      any(nil),
-		"compromised":  float64(0),
-		"hostIpc":      false,
-		"hostNetwork":  false,
-		"hostPid":      false,
-		"image":        "dockerhub.com/elasticsearch:latest",
-		"name":         "elasticsearch",
-		"node":         "test-node.ec2.internal",
-		"pod":          "app-monitors-client-78cb6d7899-j2rjp",
-		"ports":        []any{"9200"},
-		"privesc":      false,
-		"privileged":   false,
-		"runAsUser":    float64(1000),
-		"storeID":      cid.Hex(),
-		"team":         "test-team",
-		"app":          "test-app",
-		"service":      "test-service",
-		"cluster":      "test-cluster",
-		"runID":        testID.String(),
+		"args":          any(nil),
+		"isNamespaced":  true,
+		"namespace":     "test-app",
+		"capabilities":  []any{},
+		"command":       any(nil),
+		"compromised":   float64(0),
+		"containerType": "Regular",
+		"hostIpc":       false,
+		"hostNetwork":   false,
+		"hostPid":       false,
+		"image":         "dockerhub.com/elasticsearch:latest",
+		"name":          "elasticsearch",
+		"node":          "test-node.ec2.internal",
+		"pod":           "app-monitors-client-78cb6d7899-j2rjp",
+		"ports":         []any{"9200"},
+		"privesc":       false,
+		"privileged":    false,
+		"runAsUser":     float64(1000),
+		"storeID":       cid.Hex(),
+		"team":          "test-team",
+		"app":           "test-app",
+		"service":       "test-service",
+		"cluster":       "test-cluster",
+		"runID":         testID.String(),
 	}
 
 	cgw := graphdb.NewAsyncVertexWriter(t)
@@ -231,6 +242,12 @@ func TestPodIngest_Pipeline(t *testing.T) {
 	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Volume"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(vgw, nil)
 	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Endpoint"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(egw, nil)
 
+	// Graph setup - identities
+	igw := graphdb.NewAsyncVertexWriter(t)
+	igw.EXPECT().Flush(ctx).Return(nil)
+	igw.EXPECT().Close(ctx).Return(nil)
+	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Identity"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(igw, nil)
+
 	deps := &Dependencies{
 		Collector: client,
 		Cache:     c,
@@ -259,3 +276,238 @@ func TestPodIngest_Pipeline(t *testing.T) {
 	err = pi.Close(ctx)
 	assert.NoError(t, err)
 }
+
+func TestPodIngest_ServiceAccountIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakePod, err := loadTestObject[types.PodType]("testdata/pod.json")
+	assert.NoError(t, err)
+
+	sa := fakePod.Spec.ServiceAccountName
+	ns := fakePod.Namespace
+
+	// Cache setup: the service account has no direct binding but the system:serviceaccounts group is bound
+	c := mockcache.NewCacheProvider(t)
+	cw := mockcache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, cachekey.Identity(sa, ns), mock.AnythingOfType("string")).Return(nil).Once()
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx, mock.AnythingOfType("cache.WriterOption")).Return(cw, nil)
+	c.EXPECT().Get(ctx, cachekey.Identity(sa, ns)).Return(&cache.CacheResult{Err: cache.ErrNoEntry})
+	c.EXPECT().Get(ctx, cachekey.Identity("system:authenticated", "")).Return(&cache.CacheResult{Err: cache.ErrNoEntry})
+	c.EXPECT().Get(ctx, cachekey.Identity("system:serviceaccounts", "")).Return(&cache.CacheResult{Value: store.ObjectID().Hex()})
+	c.EXPECT().Get(ctx, cachekey.Identity("system:serviceaccounts:"+ns, "")).Return(&cache.CacheResult{Err: cache.ErrNoEntry})
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	isw := storedb.NewAsyncWriter(t)
+	isw.EXPECT().Queue(ctx, mock.MatchedBy(func(i *store.Identity) bool {
+		return i.Name == sa && i.Namespace == ns && i.Type == shared.IdentityTypeSA &&
+			assert.ObjectsAreEqual([]string{"system:authenticated", "system:serviceaccounts", "system:serviceaccounts:" + ns}, i.Groups)
+	})).Return(nil).Once()
+	isw.EXPECT().Flush(ctx).Return(nil)
+	isw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, collections.Identity{}, mock.Anything).Return(isw, nil)
+
+	// Graph setup
+	gdb := graphdb.NewProvider(t)
+	igw := graphdb.NewAsyncVertexWriter(t)
+	igw.EXPECT().Queue(ctx, mock.Anything).Return(nil).Once()
+	igw.EXPECT().Flush(ctx).Return(nil)
+	igw.EXPECT().Close(ctx).Return(nil)
+	gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType("*vertex.Identity"), c, mock.AnythingOfType("graphdb.WriterOption")).Return(igw, nil)
+
+	deps := &Dependencies{
+		Cache:   c,
+		GraphDB: gdb,
+		StoreDB: sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   config.NewRunID(),
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	pi := &PodIngest{
+		v: []vertex.Builder{&vertex.Pod{}, &vertex.Container{}, &vertex.Volume{}, &vertex.Endpoint{}, &vertex.Identity{}},
+		c: []collections.Collection{collections.Pod{}, collections.Container{}, collections.Volume{}, collections.Endpoint{}, collections.Identity{}},
+	}
+	pi.r, err = CreateResources(ctx, deps,
+		WithCacheReader(),
+		WithCacheWriter(cache.WithTest()),
+		WithConverterCache(),
+		WithStoreWriter(pi.c[identityIndex]),
+		WithGraphWriter(pi.v[identityIndex]))
+	assert.NoError(t, err)
+
+	err = pi.processServiceAccount(ctx, &store.Pod{K8: *fakePod})
+	assert.NoError(t, err)
+
+	err = pi.r.flushWriters(ctx)
+	assert.NoError(t, err)
+
+	err = pi.Close(ctx)
+	assert.NoError(t, err)
+}
+
+func TestPodIngest_ContainerTypes(t *testing.T) {
+	t.Parallel()
+
+	pi := &PodIngest{}
+	ctx := context.Background()
+	fakePod, err := loadTestObject[types.PodType]("testdata/pod_init_containers.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamPods(ctx, pi).
+		RunAndReturn(func(ctx context.Context, i collector.PodIngestor) error {
+			err := i.IngestPod(ctx, fakePod)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	nid := store.ObjectID()
+	c := mockcache.NewCacheProvider(t)
+	cw := mockcache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, mock.AnythingOfType("*cachekey.containerCacheKey"), mock.AnythingOfType("string")).Return(nil).Times(4)
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx, mock.AnythingOfType("cache.WriterOption")).Return(cw, nil)
+	c.EXPECT().Get(ctx, mock.AnythingOfType("*cachekey.nodeCacheKey")).Return(&cache.CacheResult{
+		Value: nid.Hex(),
+		Err:   nil,
+	})
+	c.EXPECT().Get(ctx, mock.AnythingOfType("*cachekey.identityCacheKey")).Return(&cache.CacheResult{
+		Value: store.ObjectID().Hex(),
+		Err:   nil,
+	})
+
+	// Store setup, capturing the containers
+	sdb := storedb.NewProvider(t)
+	var pid primitive.ObjectID
+	psw := storedb.NewAsyncWriter(t)
+	psw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Pod")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			pid = i.(*store.Pod).Id
+
+			return nil
+		}).Once()
+
+	containers := make(map[string]*store.Container)
+	csw := storedb.NewAsyncWriter(t)
+	csw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Container")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			sc := i.(*store.Container)
+			containers[sc.K8.Name] = sc
+
+			return nil
+		}).Times(4)
+
+	for coll, w := range map[collections.Collection]*storedb.AsyncWriter{
+		collections.Pod{}:       psw,
+		collections.Container{}: csw,
+		collections.Volume{}:    storedb.NewAsyncWriter(t),
+		collections.Endpoint{}:  storedb.NewAsyncWriter(t),
+		collections.Identity{}:  storedb.NewAsyncWriter(t),
+	} {
+		w.EXPECT().Flush(ctx).Return(nil)
+		w.EXPECT().Close(ctx).Return(nil)
+		sdb.EXPECT().BulkWriter(ctx, coll, mock.Anything).Return(w, nil)
+	}
+
+	// Graph setup, capturing the container vertices
+	gdb := graphdb.NewProvider(t)
+	pgw := graphdb.NewAsyncVertexWriter(t)
+	pgw.EXPECT().Queue(ctx, mock.Anything).Return(nil).Once()
+
+	vertices := make(map[string]map[string]any)
+	cgw := graphdb.NewAsyncVertexWriter(t)
+	cgw.EXPECT().Queue(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, v any) error {
+			cv := v.(map[string]any)
+			vertices[cv["name"].(string)] = cv
+
+			return nil
+		}).Times(4)
+
+	for builder, w := range map[string]*graphdb.AsyncVertexWriter{
+		"*vertex.Pod":       pgw,
+		"*vertex.Container": cgw,
+		"*vertex.Volume":    graphdb.NewAsyncVertexWriter(t),
+		"*vertex.Endpoint":  graphdb.NewAsyncVertexWriter(t),
+		"*vertex.Identity":  graphdb.NewAsyncVertexWriter(t),
+	} {
+		w.EXPECT().Flush(ctx).Return(nil)
+		w.EXPECT().Close(ctx).Return(nil)
+		gdb.EXPECT().VertexWriter(ctx, mock.AnythingOfType(builder), c, mock.AnythingOfType("graphdb.WriterOption")).Return(w, nil)
+	}
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		GraphDB:   gdb,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	assert.NoError(t, pi.Initialize(ctx, deps))
+	assert.NoError(t, pi.Run(ctx))
+	assert.NoError(t, pi.Close(ctx))
+
+	wantTypes := map[string]string{
+		"elasticsearch": shared.ContainerTypeRegular,
+		"init-config":   shared.ContainerTypeInit,
+		"proxy-sidecar": shared.ContainerTypeSidecar,
+		"debugger":      shared.ContainerTypeEphemeral,
+	}
+	assert.Len(t, containers, len(wantTypes))
+	assert.Len(t, vertices, len(wantTypes))
+
+	for name, want := range wantTypes {
+		// The ephemeral container overrides the pod user
+		runAsUser := int64(1000)
+		if name == "debugger" {
+			runAsUser = 0
+		}
+
+		sc, ok := containers[name]
+		if !assert.True(t, ok, "container %s not ingested", name) {
+			continue
+		}
+
+		// All container types are attached to the pod and node and inherit the pod host namespaces, from which the
+		// container escape edges are derived
+		assert.Equal(t, want, sc.Type, name)
+		assert.Equal(t, pid, sc.PodId, name)
+		assert.Equal(t, nid, sc.NodeId, name)
+		assert.Equal(t, store.ContainerInherited{
+			Namespace:      "test-app",
+			PodName:        "app-monitors-debug",
+			NodeName:       "test-node.ec2.internal",
+			HostPID:        true,
+			HostNetwork:    true,
+			ServiceAccount: "app-monitors",
+			RunAsUser:      runAsUser,
+		}, sc.Inherited, name)
+
+		cv := vertices[name]
+		assert.Equal(t, want, cv["containerType"], name)
+		assert.Equal(t, true, cv["hostPid"], name)
+		assert.Equal(t, true, cv["hostNetwork"], name)
+		assert.Equal(t, "app-monitors-debug", cv["pod"], name)
+	}
+
+	assert.Equal(t, true, vertices["debugger"]["privileged"])
+	assert.Equal(t, []any{"NET_ADMIN"}, vertices["proxy-sidecar"]["capabilities"])
+}
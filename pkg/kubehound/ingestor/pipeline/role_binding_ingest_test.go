@@ -137,6 +137,7 @@ func TestRoleBindingIngest_Pipeline(t *testing.T) {
 		"cluster":      "test-cluster",
 		"runID":        testID.String(),
 		"rules":        []interface{}{"API()::R(pods)::N()::V(get,list)", "API()::R(configmaps)::N()::V(get)", "API(apps)::R(statefulsets)::N()::V(get,list)"},
+		"aggregated":   []interface{}{},
 	}
 
 	psgw := graphdb.NewAsyncVertexWriter(t)
@@ -0,0 +1,85 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSecretIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	si := &SecretIngest{}
+
+	ctx := context.Background()
+	fakeSecret, err := loadTestObject[types.SecretType]("testdata/secret.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamSecrets(ctx, si).
+		RunAndReturn(func(ctx context.Context, i collector.SecretIngestor) error {
+			// Fake the stream of a single secret from the collector client
+			err := i.IngestSecret(ctx, fakeSecret)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	secrets := collections.Secret{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Secret")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			secret := i.(*store.Secret)
+			assert.Equal(t, "cassandra-token", secret.Name)
+			assert.Equal(t, "cassandra-temporal-dev", secret.Namespace)
+			assert.Equal(t, corev1.SecretTypeServiceAccountToken, secret.Type)
+			assert.Equal(t, shared.TokenTypeSA, secret.TokenType)
+			assert.Equal(t, "cassandra", secret.Identity)
+			assert.Equal(t, "cassandra-temporal-dev", secret.IdentityNamespace)
+			assert.Empty(t, secret.Groups)
+			assert.Equal(t, "workflow-engine", secret.Ownership.Team)
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, secrets, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = si.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = si.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = si.Close(ctx)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,76 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+)
+
+const (
+	ServiceIngestName = "k8s-service-ingest"
+)
+
+type ServiceIngest struct {
+	collection collections.Service
+	r          *IngestResources
+}
+
+var _ ObjectIngest = (*ServiceIngest)(nil)
+
+func (i *ServiceIngest) Name() string {
+	return ServiceIngestName
+}
+
+func (i *ServiceIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.collection = collections.Service{}
+
+	i.r, err = CreateResources(ctx, deps,
+		WithCacheWriter(),
+		WithStoreWriter(i.collection))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IngestService is invoked by the collector for each service collected.
+// The function ingests an input service into the cache/store databases asynchronously.
+func (i *ServiceIngest) IngestService(ctx context.Context, svc types.ServiceType) error {
+	if ok, err := preflight.CheckService(svc); !ok {
+		return err
+	}
+
+	// Normalize service to store object format
+	o, err := i.r.storeConvert.Service(ctx, svc)
+	if err != nil {
+		return err
+	}
+
+	// Async write to store
+	if err := i.r.writeStore(ctx, i.collection, o); err != nil {
+		return err
+	}
+
+	// Async write to cache so that the endpoint ingest can derive the exposure of the endpoint slices backing the service
+	return i.r.writeCache(ctx, cachekey.Service(o.Name, o.Namespace), int64(o.Exposure))
+}
+
+// Complete is invoked by the collector when all services have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *ServiceIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *ServiceIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamServices(ctx, i)
+}
+
+func (i *ServiceIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
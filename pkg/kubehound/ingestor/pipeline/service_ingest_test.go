@@ -0,0 +1,91 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	si := &ServiceIngest{}
+
+	ctx := context.Background()
+	fakeService, err := loadTestObject[types.ServiceType]("testdata/service.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamServices(ctx, si).
+		RunAndReturn(func(ctx context.Context, i collector.ServiceIngestor) error {
+			// Fake the stream of a single service from the collector client
+			err := i.IngestService(ctx, fakeService)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := mockcache.NewCacheProvider(t)
+	cw := mockcache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, cachekey.Service("cassandra-temporal-dev", "cassandra-temporal-dev"), int64(shared.EndpointExposureNodeIP)).Return(nil).Once()
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx).Return(cw, nil)
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	services := collections.Service{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Service")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			svc := i.(*store.Service)
+			assert.Equal(t, "cassandra-temporal-dev", svc.Name)
+			assert.Equal(t, "cassandra-temporal-dev", svc.Namespace)
+			assert.Equal(t, shared.EndpointExposureNodeIP, svc.Exposure)
+			assert.Equal(t, "workflow-engine", svc.Ownership.Team)
+
+			return nil
+		}).Once()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, services, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = si.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = si.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = si.Close(ctx)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+)
+
+const (
+	WebhookIngestName = "k8s-webhook-ingest"
+)
+
+// WebhookIngest ingests the admission webhooks of the mutating and validating webhook configurations into the store
+// only. Webhooks are not represented in the graph, but are used to link the containers serving mutating webhooks to the
+// pods they can mutate when building edges.
+type WebhookIngest struct {
+	collection collections.Webhook
+	r          *IngestResources
+}
+
+var _ ObjectIngest = (*WebhookIngest)(nil)
+
+func (i *WebhookIngest) Name() string {
+	return WebhookIngestName
+}
+
+func (i *WebhookIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.collection = collections.Webhook{}
+
+	i.r, err = CreateResources(ctx, deps,
+		WithStoreWriter(i.collection))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IngestMutatingWebhookConfiguration is invoked by the collector for each mutating webhook configuration collected.
+// The function ingests each webhook of the configuration into the store database asynchronously.
+func (i *WebhookIngest) IngestMutatingWebhookConfiguration(ctx context.Context,
+	config types.MutatingWebhookConfigurationType) error {
+
+	if ok, err := preflight.CheckMutatingWebhookConfiguration(config); !ok {
+		return err
+	}
+
+	for idx := range config.Webhooks {
+		o, err := i.r.storeConvert.MutatingWebhook(ctx, &config.Webhooks[idx], config)
+		if err != nil {
+			return err
+		}
+
+		if err := i.r.writeStore(ctx, i.collection, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IngestValidatingWebhookConfiguration is invoked by the collector for each validating webhook configuration collected.
+// The function ingests each webhook of the configuration into the store database asynchronously.
+func (i *WebhookIngest) IngestValidatingWebhookConfiguration(ctx context.Context,
+	config types.ValidatingWebhookConfigurationType) error {
+
+	if ok, err := preflight.CheckValidatingWebhookConfiguration(config); !ok {
+		return err
+	}
+
+	for idx := range config.Webhooks {
+		o, err := i.r.storeConvert.ValidatingWebhook(ctx, &config.Webhooks[idx], config)
+		if err != nil {
+			return err
+		}
+
+		if err := i.r.writeStore(ctx, i.collection, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Complete is invoked by the collector when all webhook configurations have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *WebhookIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *WebhookIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamWebhookConfigurations(ctx, i)
+}
+
+func (i *WebhookIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
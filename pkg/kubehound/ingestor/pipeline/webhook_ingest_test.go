@@ -0,0 +1,93 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	wi := &WebhookIngest{}
+
+	ctx := context.Background()
+	fakeConfig, err := loadTestObject[types.MutatingWebhookConfigurationType]("testdata/mutatingwebhookconfiguration.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamWebhookConfigurations(ctx, wi).
+		RunAndReturn(func(ctx context.Context, i collector.WebhookConfigurationIngestor) error {
+			// Fake the stream of a single mutating webhook configuration from the collector client
+			err := i.IngestMutatingWebhookConfiguration(ctx, fakeConfig)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	webhooks := collections.Webhook{}
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Webhook")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			webhook := i.(*store.Webhook)
+			assert.Equal(t, "MutatingWebhookConfiguration", webhook.Kind)
+			assert.Equal(t, "sidecar-injector", webhook.Configuration)
+			assert.Equal(t, "workflow-engine", webhook.Ownership.Team)
+
+			switch webhook.Name {
+			case "inject.sidecar-injector.dev":
+				// Served by a service on the default port and intercepting pod creations
+				assert.True(t, webhook.TargetsPods)
+				assert.Equal(t, &store.WebhookService{Namespace: "sidecar-injector", Name: "sidecar-injector", Port: 443}, webhook.Service)
+				assert.Equal(t, "enabled", webhook.NamespaceSelector.MatchLabels["sidecar-injection"])
+			case "external.sidecar-injector.dev":
+				// Called via an URL and intercepting deployments only
+				assert.False(t, webhook.TargetsPods)
+				assert.Nil(t, webhook.Service)
+				assert.Equal(t, "https://injector.example.com/mutate", webhook.URL)
+			default:
+				assert.Failf(t, "unexpected webhook", "webhook %s", webhook.Name)
+			}
+
+			return nil
+		}).Twice()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, webhooks, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = wi.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = wi.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = wi.Close(ctx)
+	assert.NoError(t, err)
+}
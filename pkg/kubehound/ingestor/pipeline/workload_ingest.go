@@ -0,0 +1,152 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/preflight"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+)
+
+const (
+	WorkloadIngestName = "k8s-workload-ingest"
+)
+
+// WorkloadIngest ingests the workload controllers (deployments, daemon sets, etc.) into the store only. Workloads are not
+// represented in the graph, but are used to link pods to the controllers managing them when building edges.
+type WorkloadIngest struct {
+	collection collections.Workload
+	r          *IngestResources
+}
+
+var _ ObjectIngest = (*WorkloadIngest)(nil)
+
+func (i *WorkloadIngest) Name() string {
+	return WorkloadIngestName
+}
+
+func (i *WorkloadIngest) Initialize(ctx context.Context, deps *Dependencies) error {
+	var err error
+
+	i.collection = collections.Workload{}
+
+	i.r, err = CreateResources(ctx, deps,
+		WithCacheWriter(),
+		WithStoreWriter(i.collection))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// processWorkload writes a normalized workload controller to the store and caches its store ID for the pod ingest.
+func (i *WorkloadIngest) processWorkload(ctx context.Context, o *store.Workload) error {
+	// Async write to store
+	if err := i.r.writeStore(ctx, i.collection, o); err != nil {
+		return err
+	}
+
+	// Async write to cache
+	return i.r.writeCache(ctx, cachekey.Workload(o.Kind, o.Name, o.Namespace), o.Id.Hex())
+}
+
+// IngestDeployment is invoked by the collector for each deployment collected.
+func (i *WorkloadIngest) IngestDeployment(ctx context.Context, deploy types.DeploymentType) error {
+	if ok, err := preflight.CheckWorkload(deploy); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.Deployment(ctx, deploy)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// IngestDaemonSet is invoked by the collector for each daemon set collected.
+func (i *WorkloadIngest) IngestDaemonSet(ctx context.Context, ds types.DaemonSetType) error {
+	if ok, err := preflight.CheckWorkload(ds); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.DaemonSet(ctx, ds)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// IngestStatefulSet is invoked by the collector for each stateful set collected.
+func (i *WorkloadIngest) IngestStatefulSet(ctx context.Context, sts types.StatefulSetType) error {
+	if ok, err := preflight.CheckWorkload(sts); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.StatefulSet(ctx, sts)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// IngestReplicaSet is invoked by the collector for each replica set collected.
+func (i *WorkloadIngest) IngestReplicaSet(ctx context.Context, rs types.ReplicaSetType) error {
+	if ok, err := preflight.CheckWorkload(rs); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.ReplicaSet(ctx, rs)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// IngestJob is invoked by the collector for each job collected.
+func (i *WorkloadIngest) IngestJob(ctx context.Context, job types.JobType) error {
+	if ok, err := preflight.CheckWorkload(job); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.Job(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// IngestCronJob is invoked by the collector for each cron job collected.
+func (i *WorkloadIngest) IngestCronJob(ctx context.Context, cj types.CronJobType) error {
+	if ok, err := preflight.CheckWorkload(cj); !ok {
+		return err
+	}
+
+	o, err := i.r.storeConvert.CronJob(ctx, cj)
+	if err != nil {
+		return err
+	}
+
+	return i.processWorkload(ctx, o)
+}
+
+// Complete is invoked by the collector when all workloads have been streamed.
+// The function flushes all writers and waits for completion.
+func (i *WorkloadIngest) Complete(ctx context.Context) error {
+	return i.r.flushWriters(ctx)
+}
+
+func (i *WorkloadIngest) Run(ctx context.Context) error {
+	return i.r.collect.StreamWorkloads(ctx, i)
+}
+
+func (i *WorkloadIngest) Close(ctx context.Context) error {
+	return i.r.cleanupAll(ctx)
+}
@@ -0,0 +1,114 @@
+//nolint:forcetypeassert
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/collector"
+	mockcollect "github.com/DataDog/KubeHound/pkg/collector/mockcollector"
+	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
+	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
+	mockcache "github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/mocks"
+	storedb "github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb/mocks"
+	"github.com/DataDog/KubeHound/pkg/kubehound/store/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorkloadIngest_Pipeline(t *testing.T) {
+	t.Parallel()
+	wi := &WorkloadIngest{}
+
+	ctx := context.Background()
+	fakeDaemonSet, err := loadTestObject[types.DaemonSetType]("testdata/daemonset.json")
+	assert.NoError(t, err)
+	fakeReplicaSet, err := loadTestObject[types.ReplicaSetType]("testdata/replicaset.json")
+	assert.NoError(t, err)
+
+	client := mockcollect.NewCollectorClient(t)
+	client.EXPECT().StreamWorkloads(ctx, wi).
+		RunAndReturn(func(ctx context.Context, i collector.WorkloadIngestor) error {
+			// Fake the stream of a daemon set and a replica set from the collector client
+			err := i.IngestDaemonSet(ctx, fakeDaemonSet)
+			if err != nil {
+				return err
+			}
+
+			err = i.IngestReplicaSet(ctx, fakeReplicaSet)
+			if err != nil {
+				return err
+			}
+
+			return i.Complete(ctx)
+		})
+
+	// Cache setup
+	c := mockcache.NewCacheProvider(t)
+	cw := mockcache.NewAsyncWriter(t)
+	cw.EXPECT().Queue(ctx, cachekey.Workload("DaemonSet", "node-agent", "test-app"), mock.AnythingOfType("string")).Return(nil).Once()
+	cw.EXPECT().Queue(ctx, cachekey.Workload("ReplicaSet", "app-monitors-client-78cb6d887", "test-app"), mock.AnythingOfType("string")).Return(nil).Once()
+	cw.EXPECT().Flush(ctx).Return(nil)
+	cw.EXPECT().Close(ctx).Return(nil)
+	c.EXPECT().BulkWriter(ctx).Return(cw, nil)
+
+	// Store setup
+	sdb := storedb.NewProvider(t)
+	sw := storedb.NewAsyncWriter(t)
+	workloads := collections.Workload{}
+	written := make([]*store.Workload, 0)
+	sw.EXPECT().Queue(ctx, mock.AnythingOfType("*store.Workload")).
+		RunAndReturn(func(ctx context.Context, i any) error {
+			written = append(written, i.(*store.Workload))
+
+			return nil
+		}).Twice()
+	sw.EXPECT().Flush(ctx).Return(nil)
+	sw.EXPECT().Close(ctx).Return(nil)
+	sdb.EXPECT().BulkWriter(ctx, workloads, mock.Anything).Return(sw, nil)
+
+	deps := &Dependencies{
+		Collector: client,
+		Cache:     c,
+		StoreDB:   sdb,
+		Config: &config.KubehoundConfig{
+			Builder: config.BuilderConfig{
+				Edge: config.EdgeBuilderConfig{},
+			},
+			Dynamic: config.DynamicConfig{
+				RunID:   testID,
+				Cluster: "test-cluster",
+			},
+		},
+	}
+
+	// Initialize
+	err = wi.Initialize(ctx, deps)
+	assert.NoError(t, err)
+
+	// Run
+	err = wi.Run(ctx)
+	assert.NoError(t, err)
+
+	// Close
+	err = wi.Close(ctx)
+	assert.NoError(t, err)
+
+	assert.Len(t, written, 2)
+
+	ds := written[0]
+	assert.Equal(t, "DaemonSet", ds.Kind)
+	assert.Equal(t, "apps", ds.Group)
+	assert.Equal(t, "daemonsets", ds.Resource)
+	assert.Equal(t, "7f2b3a8e-1c4d-4e5f-9a6b-0c1d2e3f4a5b", ds.UID)
+	assert.Empty(t, ds.OwnerUID)
+	assert.Equal(t, "node-agent", ds.Template.Spec.ServiceAccountName)
+	assert.Equal(t, "test-team", ds.Ownership.Team)
+
+	rs := written[1]
+	assert.Equal(t, "ReplicaSet", rs.Kind)
+	assert.Equal(t, "replicasets", rs.Resource)
+	assert.Equal(t, "9a8b7c6d-5e4f-4a3b-2c1d-0e9f8a7b6c5d", rs.OwnerUID)
+}
@@ -2,17 +2,20 @@ package ingestor
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/DataDog/KubeHound/pkg/collector"
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals"
 	"github.com/DataDog/KubeHound/pkg/kubehound/ingestor/pipeline"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/services"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/graphdb"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/storedb"
 	"github.com/DataDog/KubeHound/pkg/telemetry/log"
+	"golang.org/x/exp/slices"
 )
 
 // PipelineIngestor is a parallelized pipeline based ingestor implementation.
@@ -36,6 +39,7 @@ func ingestSequence(cfg *config.KubehoundConfig) []pipeline.Sequence {
 					Ingests: []pipeline.ObjectIngest{
 						&pipeline.RoleIngest{},
 						&pipeline.ClusterRoleIngest{},
+						&pipeline.ServiceIngest{},
 					},
 				},
 				{
@@ -49,7 +53,14 @@ func ingestSequence(cfg *config.KubehoundConfig) []pipeline.Sequence {
 					Name: "k8s-core-group",
 					Ingests: []pipeline.ObjectIngest{
 						&pipeline.NodeIngest{},
+						&pipeline.NamespaceIngest{},
+						&pipeline.WorkloadIngest{},
 						&pipeline.EndpointIngest{},
+						&pipeline.IngressIngest{},
+						&pipeline.NetworkPolicyIngest{},
+						&pipeline.SecretIngest{},
+						&pipeline.WebhookIngest{},
+						&pipeline.CustomResourceIngest{},
 					},
 				},
 				{
@@ -62,7 +73,16 @@ func ingestSequence(cfg *config.KubehoundConfig) []pipeline.Sequence {
 		},
 	}
 
-	if cfg.Collector.Type == config.CollectorTypeOpenShiftAPI || cfg.Collector.Type == config.CollectorTypeOpenShiftFile {
+	if cfg.Collector.IsOpenShift() {
+		// OpenShift groups membership is only relevant for groups bound to a role, so these must be ingested in the
+		// core sequence once all the bindings subjects are known.
+		ingestPipeline[0].Groups = slices.Insert(ingestPipeline[0].Groups, 2, pipeline.Group{
+			Name: "openshift-identity-group",
+			Ingests: []pipeline.ObjectIngest{
+				&pipeline.GroupIngest{},
+			},
+		})
+
 		openshiftIngestPipeline := pipeline.Sequence{
 			Name: "openshift-pipeline",
 			Groups: []pipeline.Group{
@@ -114,6 +134,19 @@ func (i PipelineIngestor) Run(outer context.Context) error {
 	l := log.Trace(ctx, log.WithComponent(globals.IngestorComponent))
 	l.Info("Starting ingest sequences")
 
+	// Resolve the API discovery once, to expand the RBAC rules of all the permission sets
+	lists, err := i.collector.APIResources(ctx)
+	if err != nil {
+		return fmt.Errorf("api discovery: %w", err)
+	}
+
+	discovery := libkube.DefaultAPIDiscovery()
+	if lists != nil {
+		discovery = libkube.NewAPIDiscovery(lists)
+	} else {
+		l.Warn("API discovery not available, resolving RBAC wildcards against the built-in resources only")
+	}
+
 	wg := &sync.WaitGroup{}
 	deps := &pipeline.Dependencies{
 		Config:    i.cfg,
@@ -121,6 +154,7 @@ func (i PipelineIngestor) Run(outer context.Context) error {
 		Cache:     i.cache,
 		StoreDB:   i.storedb,
 		GraphDB:   i.graphdb,
+		Discovery: discovery,
 	}
 
 	// Run the sequences in parallel and cancel ingest on any errors. Note we deliberately avoid
@@ -98,7 +98,11 @@ func createMockData(t *testing.T) (map[string]*mocks.ObjectIngest, *PipelineInge
 		},
 	}
 
+	client := collector.NewCollectorClient(t)
+	client.EXPECT().APIResources(mock.Anything).Return(nil, nil).Maybe()
+
 	pi := &PipelineIngestor{
+		collector: client,
 		sequences: seq,
 	}
 
@@ -110,10 +114,15 @@ func TestPipelineIngestor_Run(t *testing.T) {
 
 	ingests, pi := createMockData(t)
 
+	// The API discovery is resolved once and shared with all ingests
+	withDiscovery := mock.MatchedBy(func(deps *pipeline.Dependencies) bool {
+		return deps.Discovery != nil
+	})
+
 	for key, ingest := range ingests {
 		oi := ingest
 		oi.EXPECT().Name().Return(key)
-		oi.EXPECT().Initialize(mock.Anything, mock.AnythingOfType("*pipeline.Dependencies")).Return(nil).Once()
+		oi.EXPECT().Initialize(mock.Anything, withDiscovery).Return(nil).Once()
 		oi.EXPECT().Close(mock.Anything).Return(nil).Once()
 	}
 
@@ -188,3 +197,18 @@ func TestPipelineIngestor_RunExecError(t *testing.T) {
 	err := pi.Run(context.Background())
 	assert.ErrorContains(t, err, "group k8s-role-group ingest: test error")
 }
+
+func TestPipelineIngestor_RunDiscoveryError(t *testing.T) {
+	t.Parallel()
+
+	client := collector.NewCollectorClient(t)
+	client.EXPECT().APIResources(mock.Anything).Return(nil, errors.New("test error")).Once()
+
+	pi := &PipelineIngestor{
+		collector: client,
+		sequences: []pipeline.Sequence{},
+	}
+
+	err := pi.Run(context.Background())
+	assert.ErrorContains(t, err, "api discovery: test error")
+}
@@ -119,3 +119,112 @@ func CheckRoute(route types.RouteType) (bool, error) {
 
 	return true, nil
 }
+
+func CheckGroup(group types.GroupType) (bool, error) {
+	if group == nil {
+		return false, errors.New("nil group input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckNamespace checks an input K8s namespace object and reports whether it should be ingested.
+func CheckNamespace(ns types.NamespaceType) (bool, error) {
+	if ns == nil {
+		return false, errors.New("nil namespace input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckService checks an input K8s service object and reports whether it should be ingested.
+func CheckService(svc types.ServiceType) (bool, error) {
+	if svc == nil {
+		return false, errors.New("nil service input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckWorkload checks an input K8s workload controller object and reports whether it should be ingested.
+func CheckWorkload[T types.WorkloadType](w T) (bool, error) {
+	if w == nil {
+		return false, errors.New("nil workload input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckIngress checks an input K8s ingress object and reports whether it should be ingested.
+func CheckIngress(ing types.IngressType) (bool, error) {
+	if ing == nil {
+		return false, errors.New("nil ingress input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckGateway checks an input Gateway API gateway object and reports whether it should be ingested.
+func CheckGateway(gw types.GatewayType) (bool, error) {
+	if gw == nil {
+		return false, errors.New("nil gateway input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckHTTPRoute checks an input Gateway API HTTP route object and reports whether it should be ingested.
+func CheckHTTPRoute(route types.HTTPRouteType) (bool, error) {
+	if route == nil {
+		return false, errors.New("nil HTTP route input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckNetworkPolicy checks an input K8s network policy object and reports whether it should be ingested.
+func CheckNetworkPolicy(policy types.NetworkPolicyType) (bool, error) {
+	if policy == nil {
+		return false, errors.New("nil network policy input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckSecret checks an input K8s secret object and reports whether it should be ingested.
+func CheckSecret(secret types.SecretType) (bool, error) {
+	if secret == nil {
+		return false, errors.New("nil secret input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckMutatingWebhookConfiguration checks an input K8s mutating webhook configuration object and reports whether it
+// should be ingested.
+func CheckMutatingWebhookConfiguration(config types.MutatingWebhookConfigurationType) (bool, error) {
+	if config == nil {
+		return false, errors.New("nil mutating webhook configuration input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckValidatingWebhookConfiguration checks an input K8s validating webhook configuration object and reports whether
+// it should be ingested.
+func CheckValidatingWebhookConfiguration(config types.ValidatingWebhookConfigurationType) (bool, error) {
+	if config == nil {
+		return false, errors.New("nil validating webhook configuration input in preflight check")
+	}
+
+	return true, nil
+}
+
+// CheckCustomResource checks an input custom resource object and reports whether it should be ingested.
+func CheckCustomResource(cr types.CustomResourceType) (bool, error) {
+	if cr == nil {
+		return false, errors.New("nil custom resource input in preflight check")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,140 @@
+package libkube
+
+import (
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AggregatedClusterRole holds the effective rules of a cluster role defining an aggregation rule.
+type AggregatedClusterRole struct {
+	Rules   []rbacv1.PolicyRule // Effective rules of the cluster role
+	Sources []string            // Names of the cluster roles the rules were aggregated from
+}
+
+// AggregateClusterRoles resolves the aggregation rules of the provided cluster roles, mirroring the behaviour of the
+// clusterrole-aggregation controller: the rules of an aggregated cluster role are the (deduplicated) rules of all the
+// other cluster roles matching any of its selectors, in name order. Aggregation is resolved transitively so that an
+// aggregated cluster role selecting another aggregated cluster role inherits its effective rules.
+//
+// The returned map is keyed by the name of the aggregated cluster roles. Cluster roles whose selectors do not match any
+// of the provided cluster roles are omitted, as their collected rules are then the most accurate information available.
+func AggregateClusterRoles(roles []*rbacv1.ClusterRole) map[string]AggregatedClusterRole {
+	byName := make(map[string]*rbacv1.ClusterRole, len(roles))
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		if _, ok := byName[r.Name]; !ok {
+			names = append(names, r.Name)
+		}
+		byName[r.Name] = r
+	}
+	sort.Strings(names)
+
+	a := &aggregator{
+		roles:    byName,
+		names:    names,
+		resolved: make(map[string]AggregatedClusterRole),
+		visiting: make(map[string]bool),
+	}
+
+	for _, name := range names {
+		a.resolve(name)
+	}
+
+	return a.resolved
+}
+
+type aggregator struct {
+	roles    map[string]*rbacv1.ClusterRole
+	names    []string
+	resolved map[string]AggregatedClusterRole
+	visiting map[string]bool
+}
+
+// rules returns the effective rules of a cluster role.
+func (a *aggregator) rules(name string) []rbacv1.PolicyRule {
+	if agg, ok := a.resolve(name); ok {
+		return agg.Rules
+	}
+
+	return a.roles[name].Rules
+}
+
+// resolve computes the effective rules of an aggregated cluster role and reports whether the aggregation applied.
+func (a *aggregator) resolve(name string) (AggregatedClusterRole, bool) {
+	if agg, ok := a.resolved[name]; ok {
+		return agg, true
+	}
+
+	role := a.roles[name]
+	if role.AggregationRule == nil || len(role.AggregationRule.ClusterRoleSelectors) == 0 {
+		return AggregatedClusterRole{}, false
+	}
+
+	// Break aggregation cycles by falling back to the collected rules of the roles being resolved
+	if a.visiting[name] {
+		return AggregatedClusterRole{}, false
+	}
+	a.visiting[name] = true
+	defer delete(a.visiting, name)
+
+	selectors := make([]labels.Selector, 0, len(role.AggregationRule.ClusterRoleSelectors))
+	for i := range role.AggregationRule.ClusterRoleSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&role.AggregationRule.ClusterRoleSelectors[i])
+		if err != nil {
+			continue
+		}
+		selectors = append(selectors, selector)
+	}
+
+	agg := AggregatedClusterRole{
+		Rules:   make([]rbacv1.PolicyRule, 0),
+		Sources: make([]string, 0),
+	}
+
+	for _, candidate := range a.names {
+		if candidate == name || !aggregationMatch(selectors, a.roles[candidate].Labels) {
+			continue
+		}
+
+		agg.Sources = append(agg.Sources, candidate)
+		for _, rule := range a.rules(candidate) {
+			if !ruleExists(agg.Rules, rule) {
+				agg.Rules = append(agg.Rules, rule)
+			}
+		}
+	}
+
+	if len(agg.Sources) == 0 {
+		return AggregatedClusterRole{}, false
+	}
+
+	a.resolved[name] = agg
+
+	return agg, true
+}
+
+// aggregationMatch reports whether any of the selectors matches the provided labels.
+func aggregationMatch(selectors []labels.Selector, roleLabels map[string]string) bool {
+	for _, selector := range selectors {
+		if selector.Matches(labels.Set(roleLabels)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleExists reports whether a rule is already part of a rule set.
+func ruleExists(rules []rbacv1.PolicyRule, rule rbacv1.PolicyRule) bool {
+	for _, r := range rules {
+		if equality.Semantic.DeepEqual(r, rule) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAggregateClusterRoles(t *testing.T) {
+	t.Parallel()
+
+	readPods := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}
+	execPods := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods/exec"}, Verbs: []string{"create"}}
+	stale := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}}
+
+	role := func(name string, roleLabels map[string]string, selectors []map[string]string, rules ...rbacv1.PolicyRule) *rbacv1.ClusterRole {
+		cr := &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: roleLabels},
+			Rules:      rules,
+		}
+
+		if selectors != nil {
+			cr.AggregationRule = &rbacv1.AggregationRule{}
+			for _, s := range selectors {
+				cr.AggregationRule.ClusterRoleSelectors = append(cr.AggregationRule.ClusterRoleSelectors,
+					metav1.LabelSelector{MatchLabels: s})
+			}
+		}
+
+		return cr
+	}
+
+	toView := map[string]string{"aggregate-to-view": "true"}
+	toEdit := map[string]string{"aggregate-to-edit": "true"}
+
+	tests := []struct {
+		name  string
+		roles []*rbacv1.ClusterRole
+		want  map[string]AggregatedClusterRole
+	}{
+		{
+			name: "stale rules replaced",
+			roles: []*rbacv1.ClusterRole{
+				role("view", nil, []map[string]string{toView}, stale),
+				role("pod-reader", toView, nil, readPods),
+			},
+			want: map[string]AggregatedClusterRole{
+				"view": {Rules: []rbacv1.PolicyRule{readPods}, Sources: []string{"pod-reader"}},
+			},
+		},
+		{
+			name: "duplicate rules merged in name order",
+			roles: []*rbacv1.ClusterRole{
+				role("view", nil, []map[string]string{toView}),
+				role("b-reader", toView, nil, readPods, execPods),
+				role("a-reader", toView, nil, readPods),
+			},
+			want: map[string]AggregatedClusterRole{
+				"view": {Rules: []rbacv1.PolicyRule{readPods, execPods}, Sources: []string{"a-reader", "b-reader"}},
+			},
+		},
+		{
+			name: "transitive aggregation",
+			roles: []*rbacv1.ClusterRole{
+				role("edit", nil, []map[string]string{toEdit}),
+				role("view", toEdit, []map[string]string{toView}),
+				role("pod-reader", toView, nil, readPods),
+				role("pod-exec", toEdit, nil, execPods),
+			},
+			want: map[string]AggregatedClusterRole{
+				"edit": {Rules: []rbacv1.PolicyRule{execPods, readPods}, Sources: []string{"pod-exec", "view"}},
+				"view": {Rules: []rbacv1.PolicyRule{readPods}, Sources: []string{"pod-reader"}},
+			},
+		},
+		{
+			name: "aggregation cycle",
+			roles: []*rbacv1.ClusterRole{
+				role("a", toView, []map[string]string{toEdit}, readPods),
+				role("b", toEdit, []map[string]string{toView}, execPods),
+			},
+			want: map[string]AggregatedClusterRole{
+				"a": {Rules: []rbacv1.PolicyRule{readPods}, Sources: []string{"b"}},
+				"b": {Rules: []rbacv1.PolicyRule{readPods}, Sources: []string{"a"}},
+			},
+		},
+		{
+			name: "no matching role",
+			roles: []*rbacv1.ClusterRole{
+				role("view", nil, []map[string]string{toView}, stale),
+				role("pod-reader", nil, nil, readPods),
+			},
+			want: map[string]AggregatedClusterRole{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, AggregateClusterRoles(tt.roles))
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package libkube
+
+import (
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InitContainerType returns the container type of an init container. Init containers with an Always restart policy are
+// native sidecars, running alongside the regular containers for the whole lifetime of the pod.
+// See reference for details: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/
+func InitContainerType(container types.ContainerType) string {
+	if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+		return shared.ContainerTypeSidecar
+	}
+
+	return shared.ContainerTypeInit
+}
@@ -0,0 +1,39 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInitContainerType(t *testing.T) {
+	t.Parallel()
+
+	always := corev1.ContainerRestartPolicyAlways
+	tests := []struct {
+		name      string
+		container corev1.Container
+		want      string
+	}{
+		{
+			name:      "init container",
+			container: corev1.Container{Name: "setup"},
+			want:      shared.ContainerTypeInit,
+		},
+		{
+			name:      "native sidecar",
+			container: corev1.Container{Name: "proxy", RestartPolicy: &always},
+			want:      shared.ContainerTypeSidecar,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, InitContainerType(&tt.container))
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package libkube
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// WildcardAll matches any API group, resource or verb in RBAC rules.
+	WildcardAll = rbacv1.ResourceAll
+)
+
+// Permission is a single action granted by an RBAC rule, with the wildcards of the rule resolved.
+type Permission struct {
+	Group         string   // API group of the resource
+	Resource      string   // Resource name (e.g pods)
+	Subresource   string   // Subresource name (e.g exec), empty for the resource itself
+	Verb          string   // Granted verb
+	ResourceNames []string // Objects the permission is restricted to, empty for all objects
+}
+
+// APIDiscovery holds the resources (and subresources) served by a cluster with their supported verbs, as reported by
+// the API discovery. It is used to resolve the wildcards of RBAC rules into concrete permissions.
+type APIDiscovery struct {
+	groups map[string]map[string][]string // API group => resource (or resource/subresource) => verbs
+}
+
+var (
+	defaultDiscoveryOnce sync.Once
+	defaultDiscovery     *APIDiscovery
+)
+
+// specialVerbs holds the RBAC verbs checked by the API server that are not reported by the API discovery, including
+// the virtual resources only used in authorization checks (e.g users and groups for impersonation).
+// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/authorization/#determine-the-request-verb
+var specialVerbs = []struct {
+	group     string
+	resources []string
+	verbs     []string
+}{
+	{group: "rbac.authorization.k8s.io", resources: []string{"roles", "clusterroles"}, verbs: []string{"bind", "escalate"}},
+	{group: "", resources: []string{"users", "groups", "serviceaccounts"}, verbs: []string{"impersonate"}},
+	{group: "authentication.k8s.io", resources: []string{"uids", "userextras"}, verbs: []string{"impersonate"}},
+	{group: "certificates.k8s.io", resources: []string{"signers"}, verbs: []string{"approve", "sign"}},
+}
+
+// NewAPIDiscovery creates a new API discovery from the resource lists served by the cluster (one per group version).
+// The verbs of a resource served in multiple versions are merged.
+func NewAPIDiscovery(lists []*metav1.APIResourceList) *APIDiscovery {
+	d := &APIDiscovery{
+		groups: make(map[string]map[string][]string),
+	}
+
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, r := range list.APIResources {
+			d.add(gv.Group, r.Name, r.Verbs...)
+		}
+	}
+
+	for _, sv := range specialVerbs {
+		for _, r := range sv.resources {
+			d.add(sv.group, r, sv.verbs...)
+		}
+	}
+
+	return d
+}
+
+// DefaultAPIDiscovery returns the API discovery of the resources built into Kubernetes. It is used when the discovery
+// of the cluster is not available (e.g file collector dumps predating the discovery capture).
+func DefaultAPIDiscovery() *APIDiscovery {
+	defaultDiscoveryOnce.Do(func() {
+		defaultDiscovery = newDefaultAPIDiscovery()
+	})
+
+	return defaultDiscovery
+}
+
+// newDefaultAPIDiscovery builds the API discovery from the built-in resources table.
+func newDefaultAPIDiscovery() *APIDiscovery {
+	lists := make([]*metav1.APIResourceList, 0, len(defaultAPIResources))
+	for _, g := range defaultAPIResources {
+		list := &metav1.APIResourceList{
+			GroupVersion: schema.GroupVersion{Group: g.group, Version: g.version}.String(),
+		}
+
+		for _, r := range g.resources {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: r.name, Verbs: r.verbs})
+			for sub, verbs := range r.subresources {
+				list.APIResources = append(list.APIResources, metav1.APIResource{Name: r.name + "/" + sub, Verbs: verbs})
+			}
+		}
+
+		lists = append(lists, list)
+	}
+
+	return NewAPIDiscovery(lists)
+}
+
+// add registers a resource (or subresource) of an API group with the provided verbs.
+func (d *APIDiscovery) add(group string, name string, verbs ...string) {
+	resources, ok := d.groups[group]
+	if !ok {
+		resources = make(map[string][]string)
+		d.groups[group] = resources
+	}
+
+	if _, ok := resources[name]; !ok {
+		resources[name] = make([]string, 0, len(verbs))
+	}
+
+	for _, v := range verbs {
+		if !slices.Contains(resources[name], v) {
+			resources[name] = append(resources[name], v)
+		}
+	}
+}
+
+// matchGroups returns the API groups matched by the API groups of an RBAC rule.
+func (d *APIDiscovery) matchGroups(ruleGroups []string) []string {
+	if !slices.Contains(ruleGroups, WildcardAll) {
+		return ruleGroups
+	}
+
+	groups := make([]string, 0, len(d.groups))
+	for g := range d.groups {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+
+	return groups
+}
+
+// matchResources returns the resources (and subresources) of an API group matched by a resource of an RBAC rule.
+// Resources unknown to the discovery are returned as is, unless the rule resource holds a wildcard or the group was
+// matched by a wildcard (strict mode).
+func (d *APIDiscovery) matchResources(group string, ruleResource string, strict bool) []string {
+	var match func(name string) bool
+	switch {
+	case ruleResource == WildcardAll:
+		match = func(string) bool { return true }
+	case strings.HasSuffix(ruleResource, "/"+WildcardAll):
+		// All subresources of a resource (e.g pods/*)
+		prefix := strings.TrimSuffix(ruleResource, WildcardAll)
+		match = func(name string) bool { return strings.HasPrefix(name, prefix) }
+	case strings.HasPrefix(ruleResource, WildcardAll+"/"):
+		// A subresource of all resources (e.g */scale)
+		suffix := strings.TrimPrefix(ruleResource, WildcardAll)
+		match = func(name string) bool { return strings.HasSuffix(name, suffix) }
+	default:
+		if _, ok := d.groups[group][ruleResource]; !ok && strict {
+			return nil
+		}
+
+		return []string{ruleResource}
+	}
+
+	names := make([]string, 0)
+	for name := range d.groups[group] {
+		if match(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// matchVerbs returns the verbs of a resource matched by the verbs of an RBAC rule.
+func (d *APIDiscovery) matchVerbs(group string, name string, ruleVerbs []string) []string {
+	if !slices.Contains(ruleVerbs, WildcardAll) {
+		return ruleVerbs
+	}
+
+	supported, ok := d.groups[group][name]
+	if !ok {
+		return []string{WildcardAll}
+	}
+
+	return supported
+}
+
+// ExpandRules resolves the wildcards of RBAC rules against the API discovery and returns the resulting permissions,
+// one per (group, resource, subresource, verb) tuple. Wildcard verbs are expanded to the verbs supported by each
+// resource. Wildcards are kept as is for resources unknown to the discovery. Non resource URL rules are ignored.
+func (d *APIDiscovery) ExpandRules(rules []rbacv1.PolicyRule) []Permission {
+	permissions := make([]Permission, 0)
+	seen := make(map[string]struct{})
+
+	emit := func(group string, name string, verb string, resourceNames []string) {
+		resource, subresource, _ := strings.Cut(name, "/")
+		key := strings.Join(append([]string{group, resource, subresource, verb}, resourceNames...), "|")
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+
+		if len(resourceNames) == 0 {
+			// Normalize empty restrictions to simplify the matching of unrestricted permissions
+			resourceNames = nil
+		}
+
+		permissions = append(permissions, Permission{
+			Group:         group,
+			Resource:      resource,
+			Subresource:   subresource,
+			Verb:          verb,
+			ResourceNames: resourceNames,
+		})
+	}
+
+	for _, rule := range rules {
+		anyGroup := slices.Contains(rule.APIGroups, WildcardAll)
+		for _, ruleResource := range rule.Resources {
+			matched := false
+			for _, group := range d.matchGroups(rule.APIGroups) {
+				for _, name := range d.matchResources(group, ruleResource, anyGroup) {
+					matched = true
+					for _, verb := range d.matchVerbs(group, name, rule.Verbs) {
+						emit(group, name, verb, rule.ResourceNames)
+					}
+				}
+			}
+
+			if !matched && anyGroup && !strings.Contains(ruleResource, WildcardAll) {
+				// Resource unknown to the discovery in any group
+				for _, verb := range rule.Verbs {
+					emit(WildcardAll, ruleResource, verb, rule.ResourceNames)
+				}
+			}
+		}
+	}
+
+	return permissions
+}
@@ -0,0 +1,278 @@
+package libkube
+
+var (
+	verbsAll       = []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}
+	verbsReadOnly  = []string{"get", "list", "watch"}
+	verbsNoCollect = []string{"create", "delete", "get", "list", "patch", "update", "watch"}
+	verbsStatus    = []string{"get", "patch", "update"}
+	verbsCreate    = []string{"create"}
+	verbsProxy     = []string{"create", "delete", "get", "patch", "update"}
+	verbsConnect   = []string{"create", "get"}
+)
+
+type defaultAPIResource struct {
+	name         string
+	verbs        []string
+	subresources map[string][]string
+}
+
+// defaultAPIResources holds the resources built into Kubernetes with their supported verbs, as served by the API
+// discovery of a recent release.
+var defaultAPIResources = []struct {
+	group     string
+	version   string
+	resources []defaultAPIResource
+}{
+	{
+		group:   "",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "bindings", verbs: verbsCreate},
+			{name: "componentstatuses", verbs: []string{"get", "list"}},
+			{name: "configmaps", verbs: verbsAll},
+			{name: "endpoints", verbs: verbsAll},
+			{name: "events", verbs: verbsAll},
+			{name: "limitranges", verbs: verbsAll},
+			{name: "namespaces", verbs: verbsNoCollect, subresources: map[string][]string{
+				"finalize": {"update"},
+				"status":   verbsStatus,
+			}},
+			{name: "nodes", verbs: verbsAll, subresources: map[string][]string{
+				"proxy":  verbsProxy,
+				"status": verbsStatus,
+			}},
+			{name: "persistentvolumeclaims", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "persistentvolumes", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "pods", verbs: verbsAll, subresources: map[string][]string{
+				"attach":              verbsConnect,
+				"binding":             verbsCreate,
+				"ephemeralcontainers": verbsStatus,
+				"eviction":            verbsCreate,
+				"exec":                verbsConnect,
+				"log":                 {"get"},
+				"portforward":         verbsConnect,
+				"proxy":               verbsProxy,
+				"status":              verbsStatus,
+			}},
+			{name: "podtemplates", verbs: verbsAll},
+			{name: "replicationcontrollers", verbs: verbsAll, subresources: map[string][]string{
+				"scale":  verbsStatus,
+				"status": verbsStatus,
+			}},
+			{name: "resourcequotas", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "secrets", verbs: verbsAll},
+			{name: "serviceaccounts", verbs: verbsAll, subresources: map[string][]string{
+				"token": verbsCreate,
+			}},
+			{name: "services", verbs: verbsAll, subresources: map[string][]string{
+				"proxy":  verbsProxy,
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "admissionregistration.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "mutatingwebhookconfigurations", verbs: verbsAll},
+			{name: "validatingadmissionpolicies", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "validatingadmissionpolicybindings", verbs: verbsAll},
+			{name: "validatingwebhookconfigurations", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "apiextensions.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "customresourcedefinitions", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "apiregistration.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "apiservices", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "apps",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "controllerrevisions", verbs: verbsAll},
+			{name: "daemonsets", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "deployments", verbs: verbsAll, subresources: map[string][]string{
+				"scale":  verbsStatus,
+				"status": verbsStatus,
+			}},
+			{name: "replicasets", verbs: verbsAll, subresources: map[string][]string{
+				"scale":  verbsStatus,
+				"status": verbsStatus,
+			}},
+			{name: "statefulsets", verbs: verbsAll, subresources: map[string][]string{
+				"scale":  verbsStatus,
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "authentication.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "selfsubjectreviews", verbs: verbsCreate},
+			{name: "tokenreviews", verbs: verbsCreate},
+		},
+	},
+	{
+		group:   "authorization.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "localsubjectaccessreviews", verbs: verbsCreate},
+			{name: "selfsubjectaccessreviews", verbs: verbsCreate},
+			{name: "selfsubjectrulesreviews", verbs: verbsCreate},
+			{name: "subjectaccessreviews", verbs: verbsCreate},
+		},
+	},
+	{
+		group:   "autoscaling",
+		version: "v2",
+		resources: []defaultAPIResource{
+			{name: "horizontalpodautoscalers", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "batch",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "cronjobs", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "jobs", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "certificates.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "certificatesigningrequests", verbs: verbsAll, subresources: map[string][]string{
+				"approval": verbsStatus,
+				"status":   verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "coordination.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "leases", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "discovery.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "endpointslices", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "events.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "events", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "flowcontrol.apiserver.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "flowschemas", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "prioritylevelconfigurations", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "metrics.k8s.io",
+		version: "v1beta1",
+		resources: []defaultAPIResource{
+			{name: "nodes", verbs: verbsReadOnly},
+			{name: "pods", verbs: verbsReadOnly},
+		},
+	},
+	{
+		group:   "networking.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "ingressclasses", verbs: verbsAll},
+			{name: "ingresses", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+			{name: "networkpolicies", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "node.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "runtimeclasses", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "policy",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "poddisruptionbudgets", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+	{
+		group:   "rbac.authorization.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "clusterrolebindings", verbs: verbsAll},
+			{name: "clusterroles", verbs: verbsAll},
+			{name: "rolebindings", verbs: verbsAll},
+			{name: "roles", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "scheduling.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "priorityclasses", verbs: verbsAll},
+		},
+	},
+	{
+		group:   "storage.k8s.io",
+		version: "v1",
+		resources: []defaultAPIResource{
+			{name: "csidrivers", verbs: verbsAll},
+			{name: "csinodes", verbs: verbsAll},
+			{name: "csistoragecapacities", verbs: verbsAll},
+			{name: "storageclasses", verbs: verbsAll},
+			{name: "volumeattachments", verbs: verbsAll, subresources: map[string][]string{
+				"status": verbsStatus,
+			}},
+		},
+	},
+}
@@ -0,0 +1,170 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testAPIDiscovery() *APIDiscovery {
+	return NewAPIDiscovery([]*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Verbs: []string{"create", "get", "list"}},
+				{Name: "pods/exec", Verbs: []string{"create", "get"}},
+				{Name: "pods/log", Verbs: []string{"get"}},
+				{Name: "secrets", Verbs: []string{"get", "list"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Verbs: []string{"get", "patch"}},
+				{Name: "deployments/scale", Verbs: []string{"get", "update"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Verbs: []string{"get", "update"}},
+			},
+		},
+	})
+}
+
+func TestAPIDiscovery_ExpandRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules []rbacv1.PolicyRule
+		want  []Permission
+	}{
+		{
+			name: "exact rule",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods/exec"}, Verbs: []string{"create"}},
+			},
+			want: []Permission{
+				{Group: "", Resource: "pods", Subresource: "exec", Verb: "create"},
+			},
+		},
+		{
+			name: "wildcard verbs with merged versions",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}},
+			},
+			want: []Permission{
+				{Group: "apps", Resource: "deployments", Verb: "get"},
+				{Group: "apps", Resource: "deployments", Verb: "patch"},
+				{Group: "apps", Resource: "deployments", Verb: "update"},
+			},
+		},
+		{
+			name: "all subresources",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods/*"}, Verbs: []string{"get"}},
+			},
+			want: []Permission{
+				{Group: "", Resource: "pods", Subresource: "exec", Verb: "get"},
+				{Group: "", Resource: "pods", Subresource: "log", Verb: "get"},
+			},
+		},
+		{
+			name: "subresource of all resources",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"*"}, Resources: []string{"*/scale"}, Verbs: []string{"update"}},
+			},
+			want: []Permission{
+				{Group: "apps", Resource: "deployments", Subresource: "scale", Verb: "update"},
+			},
+		},
+		{
+			name: "literal resource in all groups",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"*"}, Resources: []string{"secrets"}, Verbs: []string{"get"}},
+			},
+			want: []Permission{
+				{Group: "", Resource: "secrets", Verb: "get"},
+			},
+		},
+		{
+			name: "unknown resource in all groups",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"*"}, Resources: []string{"widgets"}, Verbs: []string{"get"}},
+			},
+			want: []Permission{
+				{Group: "*", Resource: "widgets", Verb: "get"},
+			},
+		},
+		{
+			name: "unknown resource with wildcard verbs",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"example.com"}, Resources: []string{"widgets"}, Verbs: []string{"*"}},
+			},
+			want: []Permission{
+				{Group: "example.com", Resource: "widgets", Verb: "*"},
+			},
+		},
+		{
+			name: "special verbs",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"*"}, Verbs: []string{"impersonate"}},
+				{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"roles"}, Verbs: []string{"*"}},
+			},
+			want: []Permission{
+				{Group: "", Resource: "groups", Verb: "impersonate"},
+				{Group: "", Resource: "pods", Verb: "impersonate"},
+				{Group: "", Resource: "pods", Subresource: "exec", Verb: "impersonate"},
+				{Group: "", Resource: "pods", Subresource: "log", Verb: "impersonate"},
+				{Group: "", Resource: "secrets", Verb: "impersonate"},
+				{Group: "", Resource: "serviceaccounts", Verb: "impersonate"},
+				{Group: "", Resource: "users", Verb: "impersonate"},
+				{Group: "rbac.authorization.k8s.io", Resource: "roles", Verb: "bind"},
+				{Group: "rbac.authorization.k8s.io", Resource: "roles", Verb: "escalate"},
+			},
+		},
+		{
+			name: "resource names and duplicates",
+			rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}, ResourceNames: []string{"token"}},
+				{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}, ResourceNames: []string{"token"}},
+			},
+			want: []Permission{
+				{Group: "", Resource: "secrets", Verb: "get", ResourceNames: []string{"token"}},
+			},
+		},
+		{
+			name: "non resource URLs",
+			rules: []rbacv1.PolicyRule{
+				{NonResourceURLs: []string{"/healthz"}, Verbs: []string{"get"}},
+			},
+			want: []Permission{},
+		},
+	}
+
+	d := testAPIDiscovery()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ElementsMatch(t, tt.want, d.ExpandRules(tt.rules))
+		})
+	}
+}
+
+func TestDefaultAPIDiscovery(t *testing.T) {
+	t.Parallel()
+
+	perms := DefaultAPIDiscovery().ExpandRules([]rbacv1.PolicyRule{
+		{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+	})
+
+	assert.Contains(t, perms, Permission{Group: "", Resource: "pods", Subresource: "exec", Verb: "create"})
+	assert.Contains(t, perms, Permission{Group: "", Resource: "serviceaccounts", Subresource: "token", Verb: "create"})
+	assert.Contains(t, perms, Permission{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Verb: "bind"})
+	assert.NotContains(t, perms, Permission{Group: "", Resource: "pods", Subresource: "exec", Verb: "delete"})
+}
@@ -0,0 +1,21 @@
+package libkube
+
+import (
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+)
+
+const (
+	// ingressClassAnnotation is the deprecated annotation used to select the ingress controller prior to the
+	// ingressClassName field.
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+)
+
+// IngressClass returns the name of the ingress class handling the provided ingress or an empty string if the default
+// ingress class is used.
+func IngressClass(ing types.IngressType) string {
+	if ing.Spec.IngressClassName != nil {
+		return *ing.Spec.IngressClassName
+	}
+
+	return ing.Annotations[ingressClassAnnotation]
+}
@@ -0,0 +1,46 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIngressClass(t *testing.T) {
+	t.Parallel()
+
+	className := "nginx"
+	tests := []struct {
+		name string
+		ing  networkingv1.Ingress
+		want string
+	}{
+		{
+			name: "ingress class name",
+			ing:  networkingv1.Ingress{Spec: networkingv1.IngressSpec{IngressClassName: &className}},
+			want: "nginx",
+		},
+		{
+			name: "legacy annotation",
+			ing: networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{ingressClassAnnotation: "traefik"},
+			}},
+			want: "traefik",
+		},
+		{
+			name: "default class",
+			ing:  networkingv1.Ingress{},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, IngressClass(&tt.ing))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package libkube
+
+import (
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PolicyPort describes the port of a pod targeted by some traffic. The name is the name of the container port and is
+// only used to match the named ports of network policies.
+type PolicyPort struct {
+	Port     int32
+	Name     string
+	Protocol corev1.Protocol
+}
+
+// NetworkPolicyIngress reports whether a network policy restricts the ingress traffic of the pods it selects. Policies
+// without explicit policy types always apply to ingress traffic.
+func NetworkPolicyIngress(policy *networkingv1.NetworkPolicy) bool {
+	if len(policy.Spec.PolicyTypes) == 0 {
+		return true
+	}
+
+	for _, t := range policy.Spec.PolicyTypes {
+		if t == networkingv1.PolicyTypeIngress {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NetworkPolicyAccess evaluates the ingress rules of the provided network policies (which must belong to the namespace of
+// the pod) for traffic originating from the pods of the cluster to a pod port. The evaluation returns:
+//   - shared.NetworkPolicyAccessOpen if no ingress policy selects the pod or a rule allows traffic from all the pods of
+//     the cluster on the port
+//   - shared.NetworkPolicyAccessRestricted if rules only allow traffic from selected pods, namespaces or IP blocks on the port
+//   - shared.NetworkPolicyAccessDenied if the pod is isolated and no rule allows traffic on the port
+func NetworkPolicyAccess(policies []*networkingv1.NetworkPolicy, podLabels map[string]string, port PolicyPort) string {
+	isolated := false
+	restricted := false
+
+	for _, policy := range policies {
+		if !NetworkPolicyIngress(policy) {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil || !selector.Matches(labels.Set(podLabels)) {
+			continue
+		}
+
+		// The pod is isolated for ingress as soon as a single policy selects it. The allowed traffic is then the union
+		// of the ingress rules of all the policies selecting the pod.
+		isolated = true
+		for _, rule := range policy.Spec.Ingress {
+			if !networkPolicyPortsMatch(rule.Ports, port) {
+				continue
+			}
+
+			if len(rule.From) == 0 {
+				return shared.NetworkPolicyAccessOpen
+			}
+
+			for _, peer := range rule.From {
+				if networkPolicyPeerAll(peer) {
+					return shared.NetworkPolicyAccessOpen
+				}
+			}
+
+			restricted = true
+		}
+	}
+
+	switch {
+	case !isolated:
+		return shared.NetworkPolicyAccessOpen
+	case restricted:
+		return shared.NetworkPolicyAccessRestricted
+	default:
+		return shared.NetworkPolicyAccessDenied
+	}
+}
+
+// networkPolicyPortsMatch reports whether the ports of an ingress rule allow traffic to the provided pod port. Rules
+// without ports match all ports.
+func networkPolicyPortsMatch(ports []networkingv1.NetworkPolicyPort, port PolicyPort) bool {
+	if len(ports) == 0 {
+		return true
+	}
+
+	for _, p := range ports {
+		protocol := corev1.ProtocolTCP
+		if p.Protocol != nil {
+			protocol = *p.Protocol
+		}
+
+		if protocol != port.Protocol {
+			continue
+		}
+
+		switch {
+		case p.Port == nil:
+			// All the ports of the protocol
+			return true
+		case p.Port.Type == intstr.String:
+			if len(port.Name) != 0 && p.Port.StrVal == port.Name {
+				return true
+			}
+		case p.EndPort != nil:
+			if port.Port >= p.Port.IntVal && port.Port <= *p.EndPort {
+				return true
+			}
+		case p.Port.IntVal == port.Port:
+			return true
+		}
+	}
+
+	return false
+}
+
+// networkPolicyPeerAll reports whether a network policy peer selects all the pods of the cluster, i.e. an empty
+// namespace selector without any pod selector restriction.
+func networkPolicyPeerAll(peer networkingv1.NetworkPolicyPeer) bool {
+	if peer.NamespaceSelector == nil || !emptySelector(peer.NamespaceSelector) {
+		return false
+	}
+
+	return peer.PodSelector == nil || emptySelector(peer.PodSelector)
+}
+
+func emptySelector(selector *metav1.LabelSelector) bool {
+	return len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0
+}
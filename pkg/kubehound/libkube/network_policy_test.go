@@ -0,0 +1,146 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNetworkPolicyAccess(t *testing.T) {
+	t.Parallel()
+
+	udp := corev1.ProtocolUDP
+	endPort := int32(8090)
+	portNumber := intstr.FromInt(8080)
+	portName := intstr.FromString("http")
+	podLabels := map[string]string{"app": "web"}
+	httpPort := PolicyPort{Port: 8080, Name: "http", Protocol: corev1.ProtocolTCP}
+	adminPort := PolicyPort{Port: 9000, Name: "admin", Protocol: corev1.ProtocolTCP}
+
+	policy := func(selector map[string]string, types []networkingv1.PolicyType, rules ...networkingv1.NetworkPolicyIngressRule) *networkingv1.NetworkPolicy {
+		return &networkingv1.NetworkPolicy{
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: selector},
+				PolicyTypes: types,
+				Ingress:     rules,
+			},
+		}
+	}
+
+	fromPods := []networkingv1.NetworkPolicyPeer{
+		{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}},
+	}
+	fromCluster := []networkingv1.NetworkPolicyPeer{
+		{NamespaceSelector: &metav1.LabelSelector{}},
+	}
+
+	tests := []struct {
+		name     string
+		policies []*networkingv1.NetworkPolicy
+		port     PolicyPort
+		want     string
+	}{
+		{
+			name:     "no policy",
+			policies: nil,
+			port:     httpPort,
+			want:     shared.NetworkPolicyAccessOpen,
+		},
+		{
+			name:     "default deny",
+			policies: []*networkingv1.NetworkPolicy{policy(nil, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress})},
+			port:     httpPort,
+			want:     shared.NetworkPolicyAccessDenied,
+		},
+		{
+			name:     "default deny without policy types",
+			policies: []*networkingv1.NetworkPolicy{policy(map[string]string{}, nil)},
+			port:     httpPort,
+			want:     shared.NetworkPolicyAccessDenied,
+		},
+		{
+			name:     "egress only policy",
+			policies: []*networkingv1.NetworkPolicy{policy(nil, []networkingv1.PolicyType{networkingv1.PolicyTypeEgress})},
+			port:     httpPort,
+			want:     shared.NetworkPolicyAccessOpen,
+		},
+		{
+			name:     "policy selecting other pods",
+			policies: []*networkingv1.NetworkPolicy{policy(map[string]string{"app": "db"}, nil)},
+			port:     httpPort,
+			want:     shared.NetworkPolicyAccessOpen,
+		},
+		{
+			name: "allow all sources on port",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &portNumber}},
+			})},
+			port: httpPort,
+			want: shared.NetworkPolicyAccessOpen,
+		},
+		{
+			name: "allow all sources on other port",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &portNumber}},
+			})},
+			port: adminPort,
+			want: shared.NetworkPolicyAccessDenied,
+		},
+		{
+			name: "allow selected pods on named port",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &portName}},
+				From:  fromPods,
+			})},
+			port: httpPort,
+			want: shared.NetworkPolicyAccessRestricted,
+		},
+		{
+			name: "allow port range",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &portNumber, EndPort: &endPort}},
+				From:  fromPods,
+			})},
+			port: PolicyPort{Port: 8085, Protocol: corev1.ProtocolTCP},
+			want: shared.NetworkPolicyAccessRestricted,
+		},
+		{
+			name: "allow other protocol",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp}},
+			})},
+			port: httpPort,
+			want: shared.NetworkPolicyAccessDenied,
+		},
+		{
+			name: "allow all namespaces",
+			policies: []*networkingv1.NetworkPolicy{policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{
+				From: fromCluster,
+			})},
+			port: httpPort,
+			want: shared.NetworkPolicyAccessOpen,
+		},
+		{
+			name: "union of default deny and allow rule",
+			policies: []*networkingv1.NetworkPolicy{
+				policy(nil, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}),
+				policy(podLabels, nil, networkingv1.NetworkPolicyIngressRule{From: fromPods}),
+			},
+			port: httpPort,
+			want: shared.NetworkPolicyAccessRestricted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NetworkPolicyAccess(tt.policies, podLabels, tt.port))
+		})
+	}
+}
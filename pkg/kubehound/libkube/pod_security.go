@@ -0,0 +1,33 @@
+package libkube
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Pod Security Admission (PSA) namespace label and levels.
+// See: https://kubernetes.io/docs/concepts/security/pod-security-admission/
+const (
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+
+	PodSecurityLevelPrivileged = "privileged"
+	PodSecurityLevelBaseline   = "baseline"
+	PodSecurityLevelRestricted = "restricted"
+)
+
+// PodSecurityEnforceLevel returns the PSA enforce level of the provided namespace. Namespaces without the label, or with
+// an invalid level, fall back to the privileged level (default of the admission controller).
+// NOTE: cluster wide defaults set via the admission controller configuration are not visible from the namespace object.
+func PodSecurityEnforceLevel(ns *corev1.Namespace) string {
+	switch level := ns.Labels[PodSecurityEnforceLabel]; level {
+	case PodSecurityLevelBaseline, PodSecurityLevelRestricted:
+		return level
+	default:
+		return PodSecurityLevelPrivileged
+	}
+}
+
+// PrivilegedPodsBlocked returns whether the provided PSA enforce level prevents the creation of privileged pods.
+// Both the baseline and restricted levels disallow privileged containers and host namespaces.
+func PrivilegedPodsBlocked(level string) bool {
+	return level == PodSecurityLevelBaseline || level == PodSecurityLevelRestricted
+}
@@ -0,0 +1,63 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodSecurityEnforceLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		want        string
+		wantBlocked bool
+	}{
+		{
+			name:        "no label",
+			labels:      nil,
+			want:        PodSecurityLevelPrivileged,
+			wantBlocked: false,
+		},
+		{
+			name:        "privileged",
+			labels:      map[string]string{PodSecurityEnforceLabel: "privileged"},
+			want:        PodSecurityLevelPrivileged,
+			wantBlocked: false,
+		},
+		{
+			name:        "baseline",
+			labels:      map[string]string{PodSecurityEnforceLabel: "baseline"},
+			want:        PodSecurityLevelBaseline,
+			wantBlocked: true,
+		},
+		{
+			name:        "restricted",
+			labels:      map[string]string{PodSecurityEnforceLabel: "restricted", "pod-security.kubernetes.io/warn": "restricted"},
+			want:        PodSecurityLevelRestricted,
+			wantBlocked: true,
+		},
+		{
+			name:        "invalid level",
+			labels:      map[string]string{PodSecurityEnforceLabel: "strict"},
+			want:        PodSecurityLevelPrivileged,
+			wantBlocked: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: tt.labels}}
+			got := PodSecurityEnforceLevel(ns)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBlocked, PrivilegedPodsBlocked(got))
+		})
+	}
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sync"
+	"strings"
 
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -16,14 +17,20 @@ const (
 	DefaultNodeNamespace = ""
 )
 
-var (
-	ErrMissingNodeUser = errors.New("unable to resolve node user id")
+const (
+	// Groups automatically granted by Kubernetes to the authenticated identities.
+	// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/rbac/#default-roles-and-role-bindings
+	GroupAuthenticated           = "system:authenticated"
+	GroupUnauthenticated         = "system:unauthenticated"
+	GroupServiceAccounts         = "system:serviceaccounts"
+	GroupServiceAccountsPrefix   = "system:serviceaccounts:"
+	GroupAuthenticatedOAuth      = "system:authenticated:oauth" // OpenShift-specific, granted to users authenticated via OAuth
+	UserAnonymous                = "system:anonymous"
+	ServiceAccountUsernamePrefix = "system:serviceaccount:"
 )
 
 var (
-	lookupOnce sync.Once
-	lookupNid  primitive.ObjectID
-	errLookup  error
+	ErrMissingNodeUser = errors.New("unable to resolve node user id")
 )
 
 // NodeUser will return the full name of the dedicated node user.
@@ -33,24 +40,19 @@ func NodeUser(nodeName string) string {
 }
 
 // DefaultNodeIdentity will return the store id of the default system:nodes group.
+// The lookup is performed against the provided cache on each call, as the store id differs for each ingested cluster.
 // See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/node/.
 func DefaultNodeIdentity(ctx context.Context, c cache.CacheReader) (primitive.ObjectID, error) {
-	lookupOnce.Do(func() {
-		var err error
-		ck := cachekey.Identity(DefaultNodeGroup, DefaultNodeNamespace)
-
-		lookupNid, err = c.Get(ctx, ck).ObjectID()
-		switch {
-		case err == nil:
-			// NOP
-		case errors.Is(err, cache.ErrNoEntry):
-			errLookup = ErrMissingNodeUser
-		default:
-			errLookup = err
-		}
-	})
+	ck := cachekey.Identity(DefaultNodeGroup, DefaultNodeNamespace)
+	nid, err := c.Get(ctx, ck).ObjectID()
+	switch {
+	case err == nil:
+		return nid, nil
+	case errors.Is(err, cache.ErrNoEntry):
+		return primitive.NilObjectID, ErrMissingNodeUser
+	}
 
-	return lookupNid, errLookup
+	return primitive.NilObjectID, err
 }
 
 // NodeIdentity will either return the store id of the dedicated node user or store id of the default system:nodes group if a dedicated user is not present.
@@ -70,3 +72,37 @@ func NodeIdentity(ctx context.Context, c cache.CacheReader, nodeName string) (pr
 
 	return primitive.NilObjectID, fmt.Errorf("resolving node identity (%s): %w", nodeName, err)
 }
+
+// ImplicitGroups returns the groups an identity is automatically made a member of by the API server authenticators:
+//   - service accounts are members of system:serviceaccounts, system:serviceaccounts:<namespace> and system:authenticated
+//   - users are members of system:authenticated (and system:authenticated:oauth on OpenShift), except for the anonymous
+//     user which is a member of system:unauthenticated
+//   - service account usernames (system:serviceaccount:<namespace>:<name>) are handled as service accounts
+//
+// Groups have no implicit membership.
+// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/authentication/#users-in-kubernetes
+func ImplicitGroups(identityType string, name string, namespace string, openshift bool) []string {
+	switch identityType {
+	case shared.IdentityTypeSA:
+		return []string{GroupAuthenticated, GroupServiceAccounts, GroupServiceAccountsPrefix + namespace}
+	case shared.IdentityTypeUser:
+		// Service accounts can also be referenced via their username
+		if sa, ok := strings.CutPrefix(name, ServiceAccountUsernamePrefix); ok {
+			if ns, _, ok := strings.Cut(sa, ":"); ok {
+				return ImplicitGroups(shared.IdentityTypeSA, sa, ns, openshift)
+			}
+		}
+
+		if name == UserAnonymous {
+			return []string{GroupUnauthenticated}
+		}
+
+		if openshift {
+			return []string{GroupAuthenticated, GroupAuthenticatedOAuth}
+		}
+
+		return []string{GroupAuthenticated}
+	}
+
+	return nil
+}
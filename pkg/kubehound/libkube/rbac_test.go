@@ -0,0 +1,69 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImplicitGroups(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		identityType string
+		identity     string
+		namespace    string
+		openshift    bool
+		want         []string
+	}{
+		{
+			name:         "service account",
+			identityType: shared.IdentityTypeSA,
+			identity:     "app",
+			namespace:    "default",
+			want:         []string{"system:authenticated", "system:serviceaccounts", "system:serviceaccounts:default"},
+		},
+		{
+			name:         "user",
+			identityType: shared.IdentityTypeUser,
+			identity:     "alice",
+			want:         []string{"system:authenticated"},
+		},
+		{
+			name:         "openshift user",
+			identityType: shared.IdentityTypeUser,
+			identity:     "alice",
+			openshift:    true,
+			want:         []string{"system:authenticated", "system:authenticated:oauth"},
+		},
+		{
+			name:         "service account username",
+			identityType: shared.IdentityTypeUser,
+			identity:     "system:serviceaccount:kube-system:admin",
+			openshift:    true,
+			want:         []string{"system:authenticated", "system:serviceaccounts", "system:serviceaccounts:kube-system"},
+		},
+		{
+			name:         "anonymous user",
+			identityType: shared.IdentityTypeUser,
+			identity:     "system:anonymous",
+			want:         []string{"system:unauthenticated"},
+		},
+		{
+			name:         "group",
+			identityType: shared.IdentityTypeGroup,
+			identity:     "system:masters",
+			want:         nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ImplicitGroups(tt.identityType, tt.identity, tt.namespace, tt.openshift))
+		})
+	}
+}
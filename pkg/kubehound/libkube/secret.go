@@ -0,0 +1,257 @@
+package libkube
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+
+	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// Bootstrap tokens are stored in the kube-system namespace as secrets named bootstrap-token-<token id>, and
+	// authenticate as the system:bootstrap:<token id> user, member of the system:bootstrappers group.
+	// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/bootstrap-tokens/
+	BootstrapTokenSecretPrefix = "bootstrap-token-"
+	BootstrapUserPrefix        = "system:bootstrap:"
+	GroupBootstrappers         = "system:bootstrappers"
+
+	// Service account tokens authenticate as the system:serviceaccount:<namespace>:<name> user.
+	// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/service-accounts-admin/
+	ServiceAccountUserPrefix = "system:serviceaccount:"
+
+	// The identity of a kubeconfig secret is resolved from its data before the secret is redacted, and recorded in
+	// these annotations so that it can be derived from the metadata of the secret afterwards.
+	KubeconfigUserAnnotation   = "kubehound.datadoghq.com/kubeconfig-user"
+	KubeconfigGroupsAnnotation = "kubehound.datadoghq.com/kubeconfig-groups"
+)
+
+// SecretToken holds the identity a secret authenticates as.
+type SecretToken struct {
+	Type      string   // Token type (see shared.TokenTypeXXX)
+	Identity  string   // Name of the user or service account identity
+	Namespace string   // Namespace of the service account identity
+	Groups    []string // Groups implicitly granted to the token, if any
+}
+
+// RedactSecret strips the data of a secret so that only its metadata and type are retained. The last applied
+// configuration annotation set by kubectl apply is removed as it holds a copy of the data.
+func RedactSecret(secret types.SecretType) {
+	secret.Data = nil
+	secret.StringData = nil
+	delete(secret.Annotations, corev1.LastAppliedConfigAnnotation)
+}
+
+// ResolveKubeconfigSecret records the identity of the kubeconfig held by a secret (if any) in the kubeconfig
+// annotations of the secret, and reports whether an identity was resolved. The identity is resolved from the user of
+// the current context (or the only user of the kubeconfig): the subject of its client certificate, or the service
+// account its token is issued for. Kubeconfig annotations already set on the secret are always removed, as they are
+// not trusted.
+func ResolveKubeconfigSecret(secret types.SecretType) bool {
+	delete(secret.Annotations, KubeconfigUserAnnotation)
+	delete(secret.Annotations, KubeconfigGroupsAnnotation)
+
+	for _, data := range secretValues(secret) {
+		user, groups, ok := kubeconfigIdentity(data)
+		if !ok {
+			continue
+		}
+
+		encodedGroups, err := json.Marshal(groups)
+		if err != nil {
+			return false
+		}
+
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+
+		secret.Annotations[KubeconfigUserAnnotation] = user
+		if len(groups) > 0 {
+			secret.Annotations[KubeconfigGroupsAnnotation] = string(encodedGroups)
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// SecretTokenIdentity returns the identity a secret authenticates as, derived from its type and metadata only. Secrets
+// which are not service account, bootstrap tokens or resolved kubeconfigs (see ResolveKubeconfigSecret) are not
+// resolved.
+func SecretTokenIdentity(secret types.SecretType) (SecretToken, bool) {
+	if user, ok := secret.Annotations[KubeconfigUserAnnotation]; ok {
+		return kubeconfigToken(user, secret.Annotations[KubeconfigGroupsAnnotation])
+	}
+
+	switch secret.Type {
+	case corev1.SecretTypeServiceAccountToken:
+		sa := secret.Annotations[corev1.ServiceAccountNameKey]
+		if sa == "" {
+			return SecretToken{}, false
+		}
+
+		return SecretToken{Type: shared.TokenTypeSA, Identity: sa, Namespace: secret.Namespace}, true
+	case corev1.SecretTypeBootstrapToken:
+		// Bootstrap token secrets are only considered by the API server in the kube-system namespace
+		tokenID, ok := strings.CutPrefix(secret.Name, BootstrapTokenSecretPrefix)
+		if !ok || tokenID == "" || secret.Namespace != metav1.NamespaceSystem {
+			return SecretToken{}, false
+		}
+
+		return SecretToken{
+			Type:     shared.TokenTypeBoostrap,
+			Identity: BootstrapUserPrefix + tokenID,
+			Groups:   []string{GroupBootstrappers},
+		}, true
+	default:
+		return SecretToken{}, false
+	}
+}
+
+// kubeconfigToken returns the identity of a kubeconfig secret from the values of its kubeconfig annotations. Users
+// named after a service account authenticate as the service account.
+func kubeconfigToken(user string, groups string) (SecretToken, bool) {
+	if user == "" {
+		return SecretToken{}, false
+	}
+
+	if namespace, name, ok := serviceAccountUser(user); ok {
+		return SecretToken{Type: shared.TokenTypeSA, Identity: name, Namespace: namespace}, true
+	}
+
+	token := SecretToken{Type: shared.TokenTypeKubeconfig, Identity: user}
+	if groups != "" {
+		if err := json.Unmarshal([]byte(groups), &token.Groups); err != nil {
+			return SecretToken{}, false
+		}
+	}
+
+	return token, true
+}
+
+// serviceAccountUser splits a service account user name into the namespace and name of the service account.
+func serviceAccountUser(user string) (string, string, bool) {
+	sa, ok := strings.CutPrefix(user, ServiceAccountUserPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	namespace, name, ok := strings.Cut(sa, ":")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, ":") {
+		return "", "", false
+	}
+
+	return namespace, name, true
+}
+
+// secretValues returns the values of a secret, both from its data and string data.
+func secretValues(secret types.SecretType) [][]byte {
+	values := make([][]byte, 0, len(secret.Data)+len(secret.StringData))
+	for _, value := range secret.Data {
+		values = append(values, value)
+	}
+
+	for _, value := range secret.StringData {
+		values = append(values, []byte(value))
+	}
+
+	return values
+}
+
+// kubeconfigIdentity returns the user name and groups the user of a kubeconfig authenticates as. Users authenticating
+// with files, exec plugins or auth providers cannot be resolved from the kubeconfig alone.
+func kubeconfigIdentity(data []byte) (string, []string, bool) {
+	kubeconfig, err := clientcmd.Load(data)
+	if err != nil || len(kubeconfig.AuthInfos) == 0 {
+		return "", nil, false
+	}
+
+	authInfo := kubeconfigAuthInfo(kubeconfig)
+	if authInfo == nil {
+		return "", nil, false
+	}
+
+	// Client certificates take precedence over tokens in the API server authentication chain
+	if len(authInfo.ClientCertificateData) > 0 {
+		return clientCertificateIdentity(authInfo.ClientCertificateData)
+	}
+
+	if authInfo.Token != "" {
+		user, ok := serviceAccountTokenUser(authInfo.Token)
+
+		return user, nil, ok
+	}
+
+	return "", nil, false
+}
+
+// kubeconfigAuthInfo returns the user of the current context of a kubeconfig, or its only user if no context is set.
+func kubeconfigAuthInfo(kubeconfig *clientcmdapi.Config) *clientcmdapi.AuthInfo {
+	if kubeContext, ok := kubeconfig.Contexts[kubeconfig.CurrentContext]; ok {
+		return kubeconfig.AuthInfos[kubeContext.AuthInfo]
+	}
+
+	if len(kubeconfig.AuthInfos) != 1 {
+		return nil
+	}
+
+	for _, authInfo := range kubeconfig.AuthInfos {
+		return authInfo
+	}
+
+	return nil
+}
+
+// clientCertificateIdentity returns the user name (common name) and groups (organizations) of the subject of a PEM
+// encoded client certificate.
+// See reference for details: https://kubernetes.io/docs/reference/access-authn-authz/authentication/#x509-client-certificates
+func clientCertificateIdentity(data []byte) (string, []string, bool) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return "", nil, false
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil || cert.Subject.CommonName == "" {
+		return "", nil, false
+	}
+
+	return cert.Subject.CommonName, cert.Subject.Organization, true
+}
+
+// serviceAccountTokenUser returns the user a service account token authenticates as, from the subject of the token.
+// The token signature is not verified. Other bearer tokens (e.g OIDC) are not resolved as the user name depends on the
+// API server configuration.
+func serviceAccountTokenUser(token string) (string, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", false
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", false
+	}
+
+	if _, _, ok := serviceAccountUser(claims.Subject); !ok {
+		return "", false
+	}
+
+	return claims.Subject, true
+}
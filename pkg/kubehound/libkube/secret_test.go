@@ -0,0 +1,291 @@
+package libkube
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestRedactSecret(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-token",
+			Namespace: "default",
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey:       "app",
+				corev1.LastAppliedConfigAnnotation: `{"stringData":{"token":"secret"}}`,
+			},
+		},
+		Type:       corev1.SecretTypeServiceAccountToken,
+		Data:       map[string][]byte{"token": []byte("secret")},
+		StringData: map[string]string{"token": "secret"},
+	}
+
+	RedactSecret(secret)
+	assert.Nil(t, secret.Data)
+	assert.Nil(t, secret.StringData)
+	assert.Equal(t, map[string]string{corev1.ServiceAccountNameKey: "app"}, secret.Annotations)
+	assert.Equal(t, corev1.SecretTypeServiceAccountToken, secret.Type)
+}
+
+func TestSecretTokenIdentity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		secret corev1.Secret
+		want   SecretToken
+		wantOk bool
+	}{
+		{
+			name: "service account token",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "app-token",
+					Namespace:   "default",
+					Annotations: map[string]string{corev1.ServiceAccountNameKey: "app"},
+				},
+				Type: corev1.SecretTypeServiceAccountToken,
+			},
+			want:   SecretToken{Type: shared.TokenTypeSA, Identity: "app", Namespace: "default"},
+			wantOk: true,
+		},
+		{
+			name: "service account token without service account",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-token", Namespace: "default"},
+				Type:       corev1.SecretTypeServiceAccountToken,
+			},
+			wantOk: false,
+		},
+		{
+			name: "bootstrap token",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-abcdef", Namespace: "kube-system"},
+				Type:       corev1.SecretTypeBootstrapToken,
+			},
+			want: SecretToken{
+				Type:     shared.TokenTypeBoostrap,
+				Identity: "system:bootstrap:abcdef",
+				Groups:   []string{GroupBootstrappers},
+			},
+			wantOk: true,
+		},
+		{
+			name: "bootstrap token outside kube-system",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-abcdef", Namespace: "default"},
+				Type:       corev1.SecretTypeBootstrapToken,
+			},
+			wantOk: false,
+		},
+		{
+			name: "opaque secret",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-password", Namespace: "default"},
+				Type:       corev1.SecretTypeOpaque,
+			},
+			wantOk: false,
+		},
+		{
+			name: "kubeconfig user",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "admin-kubeconfig",
+					Namespace: "default",
+					Annotations: map[string]string{
+						KubeconfigUserAnnotation:   "admin",
+						KubeconfigGroupsAnnotation: `["system:masters"]`,
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			want: SecretToken{
+				Type:     shared.TokenTypeKubeconfig,
+				Identity: "admin",
+				Groups:   []string{"system:masters"},
+			},
+			wantOk: true,
+		},
+		{
+			name: "kubeconfig service account",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deployer-kubeconfig",
+					Namespace:   "default",
+					Annotations: map[string]string{KubeconfigUserAnnotation: "system:serviceaccount:ci:deployer"},
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			want:   SecretToken{Type: shared.TokenTypeSA, Identity: "deployer", Namespace: "ci"},
+			wantOk: true,
+		},
+		{
+			name: "kubeconfig invalid groups",
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "admin-kubeconfig",
+					Namespace: "default",
+					Annotations: map[string]string{
+						KubeconfigUserAnnotation:   "admin",
+						KubeconfigGroupsAnnotation: "system:masters",
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := SecretTokenIdentity(&tt.secret)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// testKubeconfig returns a kubeconfig whose current context user authenticates with the provided client certificate
+// or token.
+func testKubeconfig(t *testing.T, certData []byte, token string) []byte {
+	t.Helper()
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters["test"] = &clientcmdapi.Cluster{Server: "https://127.0.0.1:6443"}
+	kubeconfig.AuthInfos["user"] = &clientcmdapi.AuthInfo{ClientCertificateData: certData, Token: token}
+	kubeconfig.AuthInfos["other"] = &clientcmdapi.AuthInfo{Token: "other"}
+	kubeconfig.Contexts["test"] = &clientcmdapi.Context{Cluster: "test", AuthInfo: "user"}
+	kubeconfig.CurrentContext = "test"
+
+	data, err := clientcmd.Write(*kubeconfig)
+	assert.NoError(t, err)
+
+	return data
+}
+
+// testClientCertificate returns a PEM encoded self-signed client certificate for the provided subject.
+func testClientCertificate(t *testing.T, subject pkix.Name) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      subject,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// testToken returns an unsigned JWT with the provided subject.
+func testToken(subject string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + subject + `"}`))
+
+	return header + "." + payload + ".signature"
+}
+
+func TestResolveKubeconfigSecret(t *testing.T) {
+	t.Parallel()
+
+	cert := testClientCertificate(t, pkix.Name{CommonName: "admin", Organization: []string{"ops", "system:masters"}})
+
+	tests := []struct {
+		name            string
+		data            map[string][]byte
+		stringData      map[string]string
+		annotations     map[string]string
+		wantOk          bool
+		wantAnnotations map[string]string
+	}{
+		{
+			name:   "client certificate",
+			data:   map[string][]byte{"kubeconfig": testKubeconfig(t, cert, testToken("system:serviceaccount:ci:deployer"))},
+			wantOk: true,
+			wantAnnotations: map[string]string{
+				KubeconfigUserAnnotation:   "admin",
+				KubeconfigGroupsAnnotation: `["ops","system:masters"]`,
+			},
+		},
+		{
+			name:   "service account token",
+			data:   map[string][]byte{"value": testKubeconfig(t, nil, testToken("system:serviceaccount:ci:deployer"))},
+			wantOk: true,
+			wantAnnotations: map[string]string{
+				KubeconfigUserAnnotation: "system:serviceaccount:ci:deployer",
+			},
+		},
+		{
+			name:       "string data",
+			stringData: map[string]string{"config": string(testKubeconfig(t, cert, ""))},
+			wantOk:     true,
+			wantAnnotations: map[string]string{
+				KubeconfigUserAnnotation:   "admin",
+				KubeconfigGroupsAnnotation: `["ops","system:masters"]`,
+			},
+		},
+		{
+			name: "oidc token",
+			data: map[string][]byte{"kubeconfig": testKubeconfig(t, nil, testToken("alice"))},
+		},
+		{
+			name: "opaque token",
+			data: map[string][]byte{"kubeconfig": testKubeconfig(t, nil, "abcdef.0123456789abcdef")},
+		},
+		{
+			name: "not a kubeconfig",
+			data: map[string][]byte{"password": []byte("hunter2")},
+		},
+		{
+			name:            "forged annotations",
+			data:            map[string][]byte{"password": []byte("hunter2")},
+			annotations:     map[string]string{KubeconfigUserAnnotation: "admin", "team": "ops"},
+			wantAnnotations: map[string]string{"team": "ops"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "default", Annotations: tt.annotations},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       tt.data,
+				StringData: tt.stringData,
+			}
+
+			assert.Equal(t, tt.wantOk, ResolveKubeconfigSecret(secret))
+			if tt.wantAnnotations == nil {
+				assert.Empty(t, secret.Annotations)
+			} else {
+				assert.Equal(t, tt.wantAnnotations, secret.Annotations)
+			}
+		})
+	}
+}
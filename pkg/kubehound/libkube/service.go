@@ -4,14 +4,40 @@ import (
 	"fmt"
 
 	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// discoveryServiceNameLabel is the label set on EndpointSlices to reference the service they back.
+	discoveryServiceNameLabel = "kubernetes.io/service-name"
 )
 
 // ServiceName returns the name of the service associated with the provided EndpointSlice.
 func ServiceName(ep types.EndpointType) string {
-	return ep.Labels["kubernetes.io/service-name"]
+	return ep.Labels[discoveryServiceNameLabel]
 }
 
 // ServiceDns provides the DNS name of the service associated with the provided EndpointSlice.
 func ServiceDns(ep types.EndpointType) string {
-	return fmt.Sprintf("%s.%s", ep.Labels["kubernetes.io/service-name"], ep.Namespace)
+	return fmt.Sprintf("%s.%s", ep.Labels[discoveryServiceNameLabel], ep.Namespace)
+}
+
+// ServiceExposure returns the level of exposure of the endpoints backing the provided service.
+func ServiceExposure(svc types.ServiceType) shared.EndpointExposureType {
+	// External IPs are routed to the service by the cluster nodes regardless of the service type
+	if len(svc.Spec.ExternalIPs) != 0 {
+		return shared.EndpointExposureExternal
+	}
+
+	switch svc.Spec.Type {
+	case corev1.ServiceTypeLoadBalancer:
+		return shared.EndpointExposureExternal
+	case corev1.ServiceTypeNodePort:
+		return shared.EndpointExposureNodeIP
+	default:
+		// ClusterIP (including headless) services are only reachable from within the cluster. ExternalName services
+		// are a DNS alias to a name outside the cluster and do not proxy any traffic to their (manually managed) endpoints.
+		return shared.EndpointExposureClusterIP
+	}
 }
@@ -0,0 +1,64 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestServiceExposure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec corev1.ServiceSpec
+		want shared.EndpointExposureType
+	}{
+		{
+			name: "cluster IP",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+			want: shared.EndpointExposureClusterIP,
+		},
+		{
+			name: "headless",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: corev1.ClusterIPNone},
+			want: shared.EndpointExposureClusterIP,
+		},
+		{
+			name: "default type",
+			spec: corev1.ServiceSpec{},
+			want: shared.EndpointExposureClusterIP,
+		},
+		{
+			name: "node port",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort},
+			want: shared.EndpointExposureNodeIP,
+		},
+		{
+			name: "load balancer",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			want: shared.EndpointExposureExternal,
+		},
+		{
+			name: "external name",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: "db.example.com"},
+			want: shared.EndpointExposureClusterIP,
+		},
+		{
+			name: "cluster IP with external IPs",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ExternalIPs: []string{"203.0.113.10"}},
+			want: shared.EndpointExposureExternal,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ServiceExposure(&corev1.Service{Spec: tt.spec}))
+		})
+	}
+}
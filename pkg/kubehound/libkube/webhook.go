@@ -0,0 +1,71 @@
+package libkube
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// WebhookDefaultPort is the service port called by the API server when the port of a webhook service is not set.
+	WebhookDefaultPort = int32(443)
+)
+
+// WebhookTargetsPods reports whether the rules of an admission webhook intercept the creation or update of pods.
+// Rules matching only pod subresources (e.g pods/exec) are not considered.
+func WebhookTargetsPods(rules []admissionregistrationv1.RuleWithOperations) bool {
+	for _, rule := range rules {
+		if !webhookOperationsMatch(rule.Operations) {
+			continue
+		}
+
+		if !matchAny(rule.APIGroups, "", "*") {
+			continue
+		}
+
+		if matchAny(rule.Resources, "pods", "*", "*/*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookSelectorMatches reports whether an admission webhook selector matches the provided labels. A nil selector
+// matches all objects.
+func WebhookSelectorMatches(selector *metav1.LabelSelector, objLabels map[string]string) bool {
+	if selector == nil {
+		return true
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		// The API server rejects configurations with invalid selectors
+		return false
+	}
+
+	return s.Matches(labels.Set(objLabels))
+}
+
+func webhookOperationsMatch(ops []admissionregistrationv1.OperationType) bool {
+	for _, op := range ops {
+		switch op {
+		case admissionregistrationv1.Create, admissionregistrationv1.Update, admissionregistrationv1.OperationAll:
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAny(values []string, candidates ...string) bool {
+	for _, v := range values {
+		for _, c := range candidates {
+			if v == c {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package libkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func webhookRule(ops []admissionregistrationv1.OperationType, groups []string, resources []string) admissionregistrationv1.RuleWithOperations {
+	return admissionregistrationv1.RuleWithOperations{
+		Operations: ops,
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   groups,
+			APIVersions: []string{"v1"},
+			Resources:   resources,
+		},
+	}
+}
+
+func TestWebhookTargetsPods(t *testing.T) {
+	t.Parallel()
+
+	create := []admissionregistrationv1.OperationType{admissionregistrationv1.Create}
+	tests := []struct {
+		name  string
+		rules []admissionregistrationv1.RuleWithOperations
+		want  bool
+	}{
+		{
+			name:  "pod creation",
+			rules: []admissionregistrationv1.RuleWithOperations{webhookRule(create, []string{""}, []string{"pods"})},
+			want:  true,
+		},
+		{
+			name: "all operations on all resources",
+			rules: []admissionregistrationv1.RuleWithOperations{webhookRule(
+				[]admissionregistrationv1.OperationType{admissionregistrationv1.OperationAll}, []string{"*"}, []string{"*/*"})},
+			want: true,
+		},
+		{
+			name: "pod deletion",
+			rules: []admissionregistrationv1.RuleWithOperations{webhookRule(
+				[]admissionregistrationv1.OperationType{admissionregistrationv1.Delete}, []string{""}, []string{"pods"})},
+			want: false,
+		},
+		{
+			name:  "pod subresource",
+			rules: []admissionregistrationv1.RuleWithOperations{webhookRule(create, []string{""}, []string{"pods/exec"})},
+			want:  false,
+		},
+		{
+			name:  "other group",
+			rules: []admissionregistrationv1.RuleWithOperations{webhookRule(create, []string{"apps"}, []string{"*"})},
+			want:  false,
+		},
+		{
+			name: "second rule",
+			rules: []admissionregistrationv1.RuleWithOperations{
+				webhookRule(create, []string{"apps"}, []string{"deployments"}),
+				webhookRule(create, []string{""}, []string{"pods", "services"}),
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, WebhookTargetsPods(tt.rules))
+		})
+	}
+}
+
+func TestWebhookSelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	podLabels := map[string]string{"app": "web"}
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		want     bool
+	}{
+		{
+			name:     "nil selector",
+			selector: nil,
+			want:     true,
+		},
+		{
+			name:     "empty selector",
+			selector: &metav1.LabelSelector{},
+			want:     true,
+		},
+		{
+			name:     "matching labels",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			want:     true,
+		},
+		{
+			name: "excluding expression",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"web"}},
+			}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, WebhookSelectorMatches(tt.selector, podLabels))
+		})
+	}
+}
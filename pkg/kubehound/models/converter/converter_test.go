@@ -10,6 +10,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals/types"
@@ -176,6 +177,15 @@ func TestConverter_RoleBindingPipeline(t *testing.T) {
 	assert.Equal(t, linkedRole.Id, storePermissionSet.RoleId)
 	assert.Equal(t, storeBinding.Id, storePermissionSet.RoleBindingId)
 
+	permissions := []store.Permission{
+		{Group: "", Resource: "pods", Verb: "get"},
+		{Group: "", Resource: "pods", Verb: "list"},
+		{Group: "", Resource: "configmaps", Verb: "get"},
+		{Group: "apps", Resource: "statefulsets", Verb: "get"},
+		{Group: "apps", Resource: "statefulsets", Verb: "list"},
+	}
+	assert.Equal(t, permissions, storePermissionSet.Permissions)
+
 	// Store identity -> graph identity
 	graphIdentity, err := NewGraph(testConfig).Identity(storeIdentity)
 	assert.NoError(t, err, "graph role binding convert error")
@@ -596,11 +606,12 @@ func TestConverter_PodChildPipeline(t *testing.T) {
 	// Collector container -> store container
 	assert.Equal(t, 1, len(input.Spec.Containers))
 	inContainer := input.Spec.Containers[0]
-	storeContainer, err := NewStoreWithCache(testConfig, c).Container(context.TODO(), &inContainer, storePod)
+	storeContainer, err := NewStoreWithCache(testConfig, c).Container(context.TODO(), &inContainer, shared.ContainerTypeRegular, storePod)
 	assert.NoError(t, err, "store container convert error")
 
 	assert.Equal(t, storeContainer.NodeId.Hex(), nid)
 	assert.Equal(t, storeContainer.PodId, storePod.Id)
+	assert.Equal(t, shared.ContainerTypeRegular, storeContainer.Type)
 	assert.Equal(t, storeContainer.Inherited.PodName, storePod.K8.Name)
 	assert.Equal(t, storeContainer.Inherited.NodeName, storePod.K8.Spec.NodeName)
 	assert.Equal(t, storeContainer.Inherited.ServiceAccount, storePod.K8.Spec.ServiceAccountName)
@@ -614,6 +625,7 @@ func TestConverter_PodChildPipeline(t *testing.T) {
 	assert.Equal(t, graphContainer.Service, "test-service")
 	assert.Equal(t, graphContainer.Team, "test-team")
 	assert.Equal(t, storeContainer.K8.Name, graphContainer.Name)
+	assert.Equal(t, shared.ContainerTypeRegular, graphContainer.Type)
 	assert.Equal(t, storeContainer.K8.Image, graphContainer.Image)
 	assert.Equal(t, storeContainer.K8.Command, graphContainer.Command)
 	assert.Equal(t, storeContainer.K8.Args, graphContainer.Args)
@@ -700,8 +712,14 @@ func TestConverter_EndpointPipeline(t *testing.T) {
 	input, err := loadTestObject[types.EndpointType]("testdata/endpointslice.json")
 	assert.NoError(t, err, "endpoint slice load error")
 
+	c := mocks.NewCacheReader(t)
+	c.EXPECT().Get(mock.Anything, cachekey.Service("cassandra-temporal-dev", "cassandra-temporal-dev")).Return(&cache.CacheResult{
+		Value: int64(shared.EndpointExposureExternal),
+		Err:   nil,
+	})
+
 	// Collector input -> store model
-	storeEp, err := NewStore(testConfig).Endpoint(context.TODO(), input.Endpoints[0], input.Ports[0], input)
+	storeEp, err := NewStoreWithCache(testConfig, c).Endpoint(context.TODO(), input.Endpoints[0], input.Ports[0], input)
 	assert.NoError(t, err, "endpoint convert error")
 
 	assert.Equal(t, storeEp.Name, "cassandra-temporal-dev-kmwfp::TCP::cql")
@@ -735,6 +753,56 @@ func TestConverter_EndpointPipeline(t *testing.T) {
 	assert.Equal(t, shared.EndpointExposureExternal, graphEp.Exposure)
 }
 
+func TestConverter_EndpointExposure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	input, err := loadTestObject[types.EndpointType]("testdata/endpointslice.json")
+	assert.NoError(t, err, "endpoint slice load error")
+
+	tests := []struct {
+		name   string
+		result *cache.CacheResult
+		want   shared.EndpointExposureType
+	}{
+		{
+			name:   "cluster IP service",
+			result: &cache.CacheResult{Value: int64(shared.EndpointExposureClusterIP)},
+			want:   shared.EndpointExposureClusterIP,
+		},
+		{
+			name:   "node port service",
+			result: &cache.CacheResult{Value: int64(shared.EndpointExposureNodeIP)},
+			want:   shared.EndpointExposureNodeIP,
+		},
+		{
+			name:   "service not collected",
+			result: &cache.CacheResult{Value: nil, Err: cache.ErrNoEntry},
+			want:   shared.EndpointExposureClusterIP,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := mocks.NewCacheReader(t)
+			c.EXPECT().Get(ctx, cachekey.Service("cassandra-temporal-dev", "cassandra-temporal-dev")).Return(tt.result).Once()
+
+			storeEp, err := NewStoreWithCache(testConfig, c).Endpoint(ctx, input.Endpoints[0], input.Ports[0], input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, storeEp.Exposure)
+		})
+	}
+
+	c := mocks.NewCacheReader(t)
+	c.EXPECT().Get(ctx, mock.AnythingOfType("*cachekey.serviceCacheKey")).Return(&cache.CacheResult{Err: errors.New("test error")}).Once()
+
+	_, err = NewStoreWithCache(testConfig, c).Endpoint(ctx, input.Endpoints[0], input.Ports[0], input)
+	assert.ErrorContains(t, err, "test error")
+}
+
 func TestConverter_EndpointPrivatePipeline(t *testing.T) {
 	t.Parallel()
 
@@ -752,7 +820,7 @@ func TestConverter_EndpointPrivatePipeline(t *testing.T) {
 	// Collector input -> store model
 	pod, err := converter.Pod(ctx, input)
 	assert.NoError(t, err)
-	container, err := converter.Container(ctx, &pod.K8.Spec.Containers[0], pod)
+	container, err := converter.Container(ctx, &pod.K8.Spec.Containers[0], shared.ContainerTypeRegular, pod)
 	assert.NoError(t, err)
 	containerPort := container.K8.Ports[0]
 
@@ -789,3 +857,63 @@ func TestConverter_EndpointPrivatePipeline(t *testing.T) {
 	assert.Equal(t, "TCP", graphEp.Protocol)
 	assert.Equal(t, shared.EndpointExposureNodeIP, graphEp.Exposure)
 }
+
+func TestConverter_PodOwner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	controller := true
+	input := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-78cb6d887-xk2zq",
+			Namespace: "test-app",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Kind:       "ReplicaSet",
+					Name:       "app-78cb6d887",
+					Controller: &controller,
+				},
+			},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-1",
+		},
+	}
+
+	orphan := input.DeepCopy()
+	orphan.Name = "orphan"
+	orphan.OwnerReferences = nil
+
+	uncollected := input.DeepCopy()
+	uncollected.Name = "uncollected"
+	uncollected.OwnerReferences[0].Kind = "Rollout"
+
+	c := mocks.NewCacheReader(t)
+	oid := store.ObjectID()
+	c.EXPECT().Get(ctx, cachekey.Node("node-1")).Return(&cache.CacheResult{
+		Value: store.ObjectID().Hex(),
+		Err:   nil,
+	})
+	c.EXPECT().Get(ctx, cachekey.Workload("ReplicaSet", "app-78cb6d887", "test-app")).Return(&cache.CacheResult{
+		Value: oid.Hex(),
+		Err:   nil,
+	}).Once()
+	c.EXPECT().Get(ctx, cachekey.Workload("Rollout", "app-78cb6d887", "test-app")).Return(&cache.CacheResult{
+		Value: nil,
+		Err:   cache.ErrNoEntry,
+	}).Once()
+
+	sc := NewStoreWithCache(testConfig, c)
+
+	pod, err := sc.Pod(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, oid, pod.OwnerId)
+
+	pod, err = sc.Pod(ctx, orphan)
+	assert.NoError(t, err)
+	assert.True(t, pod.OwnerId.IsZero())
+
+	pod, err = sc.Pod(ctx, uncollected)
+	assert.NoError(t, err)
+	assert.True(t, pod.OwnerId.IsZero())
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,7 @@ func (c *GraphConverter) Container(input *store.Container, parent *store.Pod) (*
 		Cluster:     c.runtime.Cluster,
 		Namespace:   input.Inherited.Namespace,
 		Name:        input.K8.Name,
+		Type:        input.Type,
 		Image:       input.K8.Image,
 		Command:     input.K8.Command,
 		Args:        input.K8.Args,
@@ -199,6 +201,7 @@ func (c *GraphConverter) PermissionSet(input *store.PermissionSet) (*graph.Permi
 		Role:        input.RoleName,
 		RoleBinding: input.RoleBindingName,
 		Rules:       c.flattenPolicyRules(input.Rules),
+		Aggregated:  append(make([]string, 0, len(input.Aggregated)), input.Aggregated...),
 		Critical:    risk.Engine().IsCritical(input),
 	}
 
@@ -272,3 +275,48 @@ func (c *GraphConverter) Route(input *store.Route) (*graph.Route, error) {
 
 	return output, nil
 }
+
+// Ingress returns the graph representation of an ingress vertex from a store ingress model input.
+func (c *GraphConverter) Ingress(input *store.Ingress) (*graph.Ingress, error) {
+	output := &graph.Ingress{
+		StoreID:      input.Id.Hex(),
+		App:          input.Ownership.Application,
+		Team:         input.Ownership.Team,
+		Service:      input.Ownership.Service,
+		RunID:        c.runtime.RunID.String(),
+		Cluster:      c.runtime.Cluster,
+		IsNamespaced: input.IsNamespaced,
+		Namespace:    input.Namespace,
+		Name:         input.Name,
+		Type:         input.Type,
+		IngressClass: input.Class,
+		Hosts:        input.Hosts,
+		Gateways:     input.Gateways,
+	}
+
+	return output, nil
+}
+
+// Namespace returns the graph representation of a namespace vertex from a store namespace model input.
+func (c *GraphConverter) Namespace(input *store.Namespace) (*graph.Namespace, error) {
+	output := &graph.Namespace{
+		StoreID:            input.Id.Hex(),
+		App:                input.Ownership.Application,
+		Team:               input.Ownership.Team,
+		Service:            input.Ownership.Service,
+		RunID:              c.runtime.RunID.String(),
+		Cluster:            c.runtime.Cluster,
+		Name:               input.Name,
+		Labels:             make([]string, 0, len(input.K8.Labels)),
+		PodSecurityEnforce: input.EnforceLevel,
+		PrivilegedBlocked:  input.PrivilegedBlocked,
+	}
+
+	// Flatten the labels as key=value strings, sorted for a stable output
+	for k, v := range input.K8.Labels {
+		output.Labels = append(output.Labels, k+"="+v)
+	}
+	sort.Strings(output.Labels)
+
+	return output, nil
+}
@@ -5,12 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/DataDog/KubeHound/pkg/config"
 	"github.com/DataDog/KubeHound/pkg/globals/types"
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
 	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
@@ -35,31 +42,69 @@ var (
 
 // StoreConverter enables converting between an input K8s model to its equivalent store model.
 type StoreConverter struct {
-	cache   cache.CacheReader
-	runtime *config.DynamicConfig
+	cache     cache.CacheReader
+	discovery *libkube.APIDiscovery
+	runtime   *config.DynamicConfig
+	openshift bool
 }
 
 // NewStore returns a new store converter instance.
 func NewStore(cfg *config.KubehoundConfig) *StoreConverter {
 	return &StoreConverter{
-		runtime: &cfg.Dynamic,
+		runtime:   &cfg.Dynamic,
+		openshift: cfg.Collector.IsOpenShift(),
 	}
 }
 
 // NewStoreWithCache returns a new store converter instance with read access to the cache.
 func NewStoreWithCache(cfg *config.KubehoundConfig, cache cache.CacheReader) *StoreConverter {
 	return &StoreConverter{
-		cache:   cache,
-		runtime: &cfg.Dynamic,
+		cache:     cache,
+		runtime:   &cfg.Dynamic,
+		openshift: cfg.Collector.IsOpenShift(),
 	}
 }
 
-// Container returns the store representation of a K8s container from an input K8s container object.
-func (c *StoreConverter) Container(_ context.Context, input types.ContainerType, parent *store.Pod) (*store.Container, error) {
+// WithDiscovery sets the API discovery used to resolve the wildcards of RBAC rules. The resources built into Kubernetes
+// are used if no discovery is provided.
+func (c *StoreConverter) WithDiscovery(discovery *libkube.APIDiscovery) *StoreConverter {
+	c.discovery = discovery
+
+	return c
+}
+
+// permissions resolves the RBAC rules of a role into concrete permissions.
+func (c *StoreConverter) permissions(rules []rbacv1.PolicyRule) []store.Permission {
+	discovery := c.discovery
+	if discovery == nil {
+		discovery = libkube.DefaultAPIDiscovery()
+	}
+
+	expanded := discovery.ExpandRules(rules)
+	output := make([]store.Permission, 0, len(expanded))
+	for _, p := range expanded {
+		output = append(output, store.Permission{
+			Group:         p.Group,
+			Resource:      p.Resource,
+			Subresource:   p.Subresource,
+			Verb:          p.Verb,
+			ResourceNames: p.ResourceNames,
+		})
+	}
+
+	return output
+}
+
+// Container returns the store representation of a K8s container from an input K8s container object. The container type
+// (regular, init, sidecar or ephemeral) is determined from the pod spec list the container is defined in.
+func (c *StoreConverter) Container(_ context.Context, input types.ContainerType, containerType string,
+	parent *store.Pod) (*store.Container, error) {
+
 	output := &store.Container{
 		Id:     store.ObjectID(),
 		PodId:  parent.Id,
 		NodeId: parent.NodeId,
+		Type:   containerType,
 		Inherited: store.ContainerInherited{
 			PodName:        parent.K8.Name,
 			NodeName:       parent.K8.Spec.NodeName,
@@ -129,9 +174,15 @@ func (c *StoreConverter) Pod(ctx context.Context, input types.PodType) (*store.P
 		return nil, err
 	}
 
+	oid, err := c.podOwner(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
 	output := &store.Pod{
 		Id:        store.ObjectID(),
 		NodeId:    nid,
+		OwnerId:   oid,
 		K8:        *input,
 		Ownership: store.ExtractOwnership(input.ObjectMeta.Labels),
 		Runtime:   store.Runtime(c.runtime),
@@ -144,6 +195,26 @@ func (c *StoreConverter) Pod(ctx context.Context, input types.PodType) (*store.P
 	return output, nil
 }
 
+// podOwner returns the store ID of the workload controller managing the pod, or a nil ID if the pod is not managed by a
+// collected workload controller.
+func (c *StoreConverter) podOwner(ctx context.Context, input types.PodType) (primitive.ObjectID, error) {
+	owner := metav1.GetControllerOfNoCopy(&input.ObjectMeta)
+	if owner == nil {
+		return primitive.NilObjectID, nil
+	}
+
+	oid, err := c.cache.Get(ctx, cachekey.Workload(owner.Kind, owner.Name, input.Namespace)).ObjectID()
+	switch {
+	case err == nil:
+		return oid, nil
+	case errors.Is(err, cache.ErrNoEntry):
+		// Controllers that are not collected (e.g static pod mirrors, operator custom resources)
+		return primitive.NilObjectID, nil
+	default:
+		return primitive.NilObjectID, err
+	}
+}
+
 // handleProjectedToken returns the identity store ID and source path corresponding to a projected token volume mount.
 func (c *StoreConverter) handleProjectedToken(ctx context.Context, input types.VolumeMountType,
 	volume *corev1.Volume, pod *store.Pod) (primitive.ObjectID, string, error) {
@@ -258,9 +329,9 @@ func (c *StoreConverter) ClusterRole(_ context.Context, input types.ClusterRoleT
 	}, nil
 }
 
-func (c *StoreConverter) convertSubject(ctx context.Context, subj rbacv1.Subject) (store.BindSubject, error) {
-	// Check if identity already exists and use that ID, otherwise generate a new one
-	sid, err := c.cache.Get(ctx, cachekey.Identity(subj.Name, subj.Namespace)).ObjectID()
+// identityID returns the id of an identity if it already exists, otherwise generates a new one.
+func (c *StoreConverter) identityID(ctx context.Context, name string, namespace string) (primitive.ObjectID, error) {
+	sid, err := c.cache.Get(ctx, cachekey.Identity(name, namespace)).ObjectID()
 	switch {
 	case err == nil:
 		// Entry already exists, use the cached id value
@@ -268,6 +339,16 @@ func (c *StoreConverter) convertSubject(ctx context.Context, subj rbacv1.Subject
 		// Entry does not exist, create a new id value
 		sid = store.ObjectID()
 	default:
+		return primitive.NilObjectID, err
+	}
+
+	return sid, nil
+}
+
+func (c *StoreConverter) convertSubject(ctx context.Context, subj rbacv1.Subject) (store.BindSubject, error) {
+	// Check if identity already exists and use that ID, otherwise generate a new one
+	sid, err := c.identityID(ctx, subj.Name, subj.Namespace)
+	if err != nil {
 		return store.BindSubject{}, err
 	}
 
@@ -380,9 +461,58 @@ func (c *StoreConverter) Identity(_ context.Context, input *store.BindSubject, p
 		output.Namespace = input.Subject.Namespace
 	}
 
+	output.Groups = libkube.ImplicitGroups(output.Type, output.Name, output.Namespace, c.openshift)
+
 	return output, nil
 }
 
+// ServiceAccountIdentity returns the store representation of the service account identity a pod is running under.
+// NOTE: requires cache access (IdentityKey) to reuse the id of an identity already created from a role binding subject.
+func (c *StoreConverter) ServiceAccountIdentity(ctx context.Context, pod *store.Pod) (*store.Identity, error) {
+	if c.cache == nil {
+		return nil, ErrNoCacheInitialized
+	}
+
+	name := pod.K8.Spec.ServiceAccountName
+	sid, err := c.identityID(ctx, name, pod.K8.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &store.Identity{
+		Id:           sid,
+		Name:         name,
+		IsNamespaced: true,
+		Namespace:    pod.K8.Namespace,
+		Type:         shared.IdentityTypeSA,
+		Groups:       libkube.ImplicitGroups(shared.IdentityTypeSA, name, pod.K8.Namespace, c.openshift),
+		Ownership:    pod.Ownership,
+		Runtime:      store.Runtime(c.runtime),
+	}, nil
+}
+
+// GroupMember returns the store representation of a user identity listed as a member of an explicit OpenShift group.
+// NOTE: requires cache access (IdentityKey) to reuse the id of an identity already created from a role binding subject.
+func (c *StoreConverter) GroupMember(ctx context.Context, user string, group *store.Group) (*store.Identity, error) {
+	if c.cache == nil {
+		return nil, ErrNoCacheInitialized
+	}
+
+	sid, err := c.identityID(ctx, user, EmptyNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &store.Identity{
+		Id:        sid,
+		Name:      user,
+		Type:      shared.IdentityTypeUser,
+		Groups:    libkube.ImplicitGroups(shared.IdentityTypeUser, user, EmptyNamespace, c.openshift),
+		Ownership: group.Ownership,
+		Runtime:   store.Runtime(c.runtime),
+	}, nil
+}
+
 // PermissionSet returns the store representation of a K8s role / rolebinding combination from input K8s objects.
 // RBAC rules and limitation:
 //   - Roles and RoleBindings must exist in the same namespace.
@@ -446,6 +576,8 @@ func (c *StoreConverter) PermissionSet(ctx context.Context, roleBinding *store.R
 		IsNamespaced:    role.IsNamespaced,
 		Namespace:       role.Namespace,
 		Rules:           role.Rules,
+		Permissions:     c.permissions(role.Rules),
+		Aggregated:      role.Aggregated,
 		Ownership:       role.Ownership,
 		Runtime:         store.Runtime(c.runtime),
 	}
@@ -496,6 +628,8 @@ func (c *StoreConverter) PermissionSetCluster(ctx context.Context, clusterRoleBi
 		IsNamespaced:    role.IsNamespaced,
 		Namespace:       role.Namespace,
 		Rules:           role.Rules,
+		Permissions:     c.permissions(role.Rules),
+		Aggregated:      role.Aggregated,
 		Ownership:       role.Ownership,
 		Runtime:         store.Runtime(c.runtime),
 	}
@@ -505,7 +639,7 @@ func (c *StoreConverter) PermissionSetCluster(ctx context.Context, clusterRoleBi
 
 // Endpoint returns the store representation of a K8s endpoint from an input Endpoint & EndpointPort objects (subfields of EndpointSlice).
 // NOTE: store.Endpoint does not map directly to a K8s API object and instead derives from the elements of an EndpointSlice.
-func (c *StoreConverter) Endpoint(_ context.Context, addr discoveryv1.Endpoint,
+func (c *StoreConverter) Endpoint(ctx context.Context, addr discoveryv1.Endpoint,
 	port discoveryv1.EndpointPort, parent types.EndpointType) (*store.Endpoint, error) {
 
 	// Ensure we have a target
@@ -518,6 +652,11 @@ func (c *StoreConverter) Endpoint(_ context.Context, addr discoveryv1.Endpoint,
 		return nil, ErrEndpointTarget
 	}
 
+	exposure, err := c.endpointExposure(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
 	output := &store.Endpoint{
 		Id:           store.ObjectID(),
 		PodName:      addr.TargetRef.Name,
@@ -532,9 +671,7 @@ func (c *StoreConverter) Endpoint(_ context.Context, addr discoveryv1.Endpoint,
 		Ownership:    store.ExtractOwnership(parent.ObjectMeta.Labels),
 		Runtime:      store.Runtime(c.runtime),
 		K8:           parent.ObjectMeta,
-
-		// If created via the ingestion pipeline the endpoint corresponds to a k8s endpoint slice
-		Exposure: shared.EndpointExposureExternal,
+		Exposure:     exposure,
 	}
 
 	if addr.NodeName != nil {
@@ -549,6 +686,21 @@ func (c *StoreConverter) Endpoint(_ context.Context, addr discoveryv1.Endpoint,
 	return output, nil
 }
 
+// endpointExposure returns the level of exposure of the endpoints of a K8s endpoint slice, derived from the type of the
+// service the slice is backing.
+func (c *StoreConverter) endpointExposure(ctx context.Context, parent types.EndpointType) (shared.EndpointExposureType, error) {
+	exposure, err := c.cache.Get(ctx, cachekey.Service(libkube.ServiceName(parent), parent.Namespace)).Int64()
+	switch {
+	case err == nil:
+		return shared.EndpointExposureType(exposure), nil
+	case errors.Is(err, cache.ErrNoEntry):
+		// Slices not backing a collected service (e.g manually managed slices) are at least reachable from within the cluster
+		return shared.EndpointExposureClusterIP, nil
+	default:
+		return shared.EndpointExposureNone, err
+	}
+}
+
 // EndpointPrivate returns the store representation of a K8s endpoint from an input port, container & pod.
 // This variant handles the case when the provided container port does not match a known EndpointSlice. The generated endpoint will
 // not be accessible from outside the cluster but can still provide value to an attacker with an presence inside the cluster.
@@ -629,3 +781,343 @@ func (c *StoreConverter) Route(ctx context.Context, input types.RouteType) (*sto
 		Runtime:      store.Runtime(c.runtime),
 	}, nil
 }
+
+// Group returns the store representation of an OpenShift group from an input OpenShift Group object.
+func (c *StoreConverter) Group(_ context.Context, input types.GroupType) (*store.Group, error) {
+	return &store.Group{
+		Id:        store.ObjectID(),
+		Name:      input.Name,
+		Users:     append(make([]string, 0, len(input.Users)), input.Users...),
+		K8:        *input,
+		Ownership: store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:   store.Runtime(c.runtime),
+	}, nil
+}
+
+// Namespace returns the store representation of a K8s namespace from an input K8s namespace object.
+func (c *StoreConverter) Namespace(_ context.Context, input types.NamespaceType) (*store.Namespace, error) {
+	level := libkube.PodSecurityEnforceLevel(input)
+
+	return &store.Namespace{
+		Id:                store.ObjectID(),
+		Name:              input.Name,
+		EnforceLevel:      level,
+		PrivilegedBlocked: libkube.PrivilegedPodsBlocked(level),
+		K8:                *input,
+		Ownership:         store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:           store.Runtime(c.runtime),
+	}, nil
+}
+
+// Service returns the store representation of a K8s service from an input K8s service object.
+func (c *StoreConverter) Service(_ context.Context, input types.ServiceType) (*store.Service, error) {
+	return &store.Service{
+		Id:        store.ObjectID(),
+		Name:      input.Name,
+		Namespace: input.Namespace,
+		Exposure:  libkube.ServiceExposure(input),
+		K8:        *input,
+		Ownership: store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:   store.Runtime(c.runtime),
+	}, nil
+}
+
+// Ingress returns the store representation of a K8s ingress from an input K8s ingress object. Only the backends
+// referencing a service are retained.
+func (c *StoreConverter) Ingress(_ context.Context, input types.IngressType) (*store.Ingress, error) {
+	output := &store.Ingress{
+		Id:           store.ObjectID(),
+		Type:         store.IngressTypeIngress,
+		IsNamespaced: true,
+		Namespace:    input.Namespace,
+		Name:         input.Name,
+		Class:        libkube.IngressClass(input),
+		Hosts:        make([]string, 0, len(input.Spec.Rules)),
+		Gateways:     make([]string, 0),
+		Backends:     make([]store.IngressBackend, 0),
+		K8:           input.ObjectMeta,
+		Ownership:    store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:      store.Runtime(c.runtime),
+	}
+
+	addBackend := func(backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil {
+			return
+		}
+
+		b := store.IngressBackend{
+			Namespace:   input.Namespace,
+			ServiceName: backend.Service.Name,
+			Port:        backend.Service.Port.Number,
+			PortName:    backend.Service.Port.Name,
+		}
+		if !slices.Contains(output.Backends, b) {
+			output.Backends = append(output.Backends, b)
+		}
+	}
+
+	addBackend(input.Spec.DefaultBackend)
+	for _, rule := range input.Spec.Rules {
+		if len(rule.Host) != 0 && !slices.Contains(output.Hosts, rule.Host) {
+			output.Hosts = append(output.Hosts, rule.Host)
+		}
+
+		if rule.HTTP == nil {
+			continue
+		}
+
+		for i := range rule.HTTP.Paths {
+			addBackend(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+
+	return output, nil
+}
+
+// Gateway returns the store representation of a Gateway API gateway from an input gateway object.
+func (c *StoreConverter) Gateway(_ context.Context, input types.GatewayType) (*store.Gateway, error) {
+	return &store.Gateway{
+		Id:           store.ObjectID(),
+		IsNamespaced: true,
+		Namespace:    input.Namespace,
+		Name:         input.Name,
+		K8:           *input,
+		Ownership:    store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:      store.Runtime(c.runtime),
+	}, nil
+}
+
+// HTTPRoute returns the store representation of a Gateway API HTTP route from an input HTTP route object. Only the
+// parent references to gateways and the backend references to services are retained. The class and the hostnames
+// inherited from the parent gateways are resolved by the caller.
+func (c *StoreConverter) HTTPRoute(_ context.Context, input types.HTTPRouteType) (*store.Ingress, error) {
+	output := &store.Ingress{
+		Id:           store.ObjectID(),
+		Type:         store.IngressTypeHTTPRoute,
+		IsNamespaced: true,
+		Namespace:    input.Namespace,
+		Name:         input.Name,
+		Hosts:        make([]string, 0, len(input.Spec.Hostnames)),
+		Gateways:     make([]string, 0, len(input.Spec.ParentRefs)),
+		Backends:     make([]store.IngressBackend, 0),
+		K8:           input.ObjectMeta,
+		Ownership:    store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:      store.Runtime(c.runtime),
+	}
+
+	output.Hosts = append(output.Hosts, input.Spec.Hostnames...)
+
+	for _, ref := range input.Spec.ParentRefs {
+		if !gatewayapi.IsGatewayRef(ref) {
+			continue
+		}
+
+		gw := fmt.Sprintf("%s/%s", gatewayapi.RefNamespace(ref.Namespace, input.Namespace), ref.Name)
+		if !slices.Contains(output.Gateways, gw) {
+			output.Gateways = append(output.Gateways, gw)
+		}
+	}
+
+	for _, rule := range input.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			if !gatewayapi.IsServiceRef(ref.BackendObjectReference) {
+				continue
+			}
+
+			b := store.IngressBackend{
+				Namespace:   gatewayapi.RefNamespace(ref.Namespace, input.Namespace),
+				ServiceName: ref.Name,
+			}
+			if ref.Port != nil {
+				b.Port = *ref.Port
+			}
+
+			if !slices.Contains(output.Backends, b) {
+				output.Backends = append(output.Backends, b)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// NetworkPolicy returns the store representation of a K8s network policy from an input K8s network policy object.
+func (c *StoreConverter) NetworkPolicy(_ context.Context, input types.NetworkPolicyType) (*store.NetworkPolicy, error) {
+	return &store.NetworkPolicy{
+		Id:        store.ObjectID(),
+		Name:      input.Name,
+		Namespace: input.Namespace,
+		K8:        *input,
+		Ownership: store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:   store.Runtime(c.runtime),
+	}, nil
+}
+
+// Secret returns the store representation of a K8s secret from an input K8s secret object. Only the metadata and type
+// of the secret are retained, along with the identity the secret authenticates as (if any).
+func (c *StoreConverter) Secret(_ context.Context, input types.SecretType) (*store.Secret, error) {
+	output := &store.Secret{
+		Id:        store.ObjectID(),
+		Name:      input.Name,
+		Namespace: input.Namespace,
+		Type:      input.Type,
+		K8:        input.ObjectMeta,
+		Ownership: store.ExtractOwnership(input.ObjectMeta.Labels),
+		Runtime:   store.Runtime(c.runtime),
+	}
+
+	if token, ok := libkube.SecretTokenIdentity(input); ok {
+		output.TokenType = token.Type
+		output.Identity = token.Identity
+		output.IdentityNamespace = token.Namespace
+		output.Groups = token.Groups
+	}
+
+	return output, nil
+}
+
+// webhook returns the store representation of a single admission webhook from the fields shared by mutating and
+// validating webhooks.
+func (c *StoreConverter) webhook(kind string, meta *metav1.ObjectMeta, name string,
+	client *admissionregistrationv1.WebhookClientConfig, rules []admissionregistrationv1.RuleWithOperations,
+	failurePolicy *admissionregistrationv1.FailurePolicyType, nsSelector *metav1.LabelSelector,
+	objSelector *metav1.LabelSelector) *store.Webhook {
+
+	output := &store.Webhook{
+		Id:                store.ObjectID(),
+		Kind:              kind,
+		Configuration:     meta.Name,
+		Name:              name,
+		TargetsPods:       libkube.WebhookTargetsPods(rules),
+		NamespaceSelector: nsSelector,
+		ObjectSelector:    objSelector,
+		K8:                *meta,
+		Ownership:         store.ExtractOwnership(meta.Labels),
+		Runtime:           store.Runtime(c.runtime),
+	}
+
+	if failurePolicy != nil {
+		output.FailurePolicy = string(*failurePolicy)
+	}
+
+	if client.URL != nil {
+		output.URL = *client.URL
+	}
+
+	if client.Service != nil {
+		output.Service = &store.WebhookService{
+			Namespace: client.Service.Namespace,
+			Name:      client.Service.Name,
+			Port:      libkube.WebhookDefaultPort,
+		}
+
+		if client.Service.Port != nil {
+			output.Service.Port = *client.Service.Port
+		}
+	}
+
+	return output
+}
+
+// MutatingWebhook returns the store representation of a K8s mutating admission webhook from its parent configuration.
+func (c *StoreConverter) MutatingWebhook(_ context.Context, input *admissionregistrationv1.MutatingWebhook,
+	parent types.MutatingWebhookConfigurationType) (*store.Webhook, error) {
+
+	return c.webhook("MutatingWebhookConfiguration", &parent.ObjectMeta, input.Name, &input.ClientConfig, input.Rules,
+		input.FailurePolicy, input.NamespaceSelector, input.ObjectSelector), nil
+}
+
+// ValidatingWebhook returns the store representation of a K8s validating admission webhook from its parent configuration.
+func (c *StoreConverter) ValidatingWebhook(_ context.Context, input *admissionregistrationv1.ValidatingWebhook,
+	parent types.ValidatingWebhookConfigurationType) (*store.Webhook, error) {
+
+	return c.webhook("ValidatingWebhookConfiguration", &parent.ObjectMeta, input.Name, &input.ClientConfig, input.Rules,
+		input.FailurePolicy, input.NamespaceSelector, input.ObjectSelector), nil
+}
+
+// CustomResource returns the store representation of an object of a configured custom resource, with the unstructured
+// content of the object stored as is.
+func (c *StoreConverter) CustomResource(_ context.Context, gvr schema.GroupVersionResource,
+	input types.CustomResourceType) (*store.CustomResource, error) {
+
+	u := (*unstructured.Unstructured)(input)
+	output := &store.CustomResource{
+		Id:        store.ObjectID(),
+		Group:     gvr.Group,
+		Version:   u.GroupVersionKind().Version,
+		Resource:  gvr.Resource,
+		Kind:      u.GetKind(),
+		Name:      u.GetName(),
+		Namespace: u.GetNamespace(),
+		UID:       string(u.GetUID()),
+		K8:        u.UnstructuredContent(),
+		Ownership: store.ExtractOwnership(u.GetLabels()),
+		Runtime:   store.Runtime(c.runtime),
+	}
+
+	if output.Version == "" {
+		output.Version = gvr.Version
+	}
+
+	if owner := metav1.GetControllerOf(u); owner != nil {
+		output.OwnerKind = owner.Kind
+		output.OwnerName = owner.Name
+		output.OwnerUID = string(owner.UID)
+	}
+
+	return output, nil
+}
+
+// workload returns the store representation of a K8s workload controller from its metadata and pod template.
+func (c *StoreConverter) workload(kind string, group string, resource string, meta *metav1.ObjectMeta,
+	template *corev1.PodTemplateSpec) *store.Workload {
+
+	output := &store.Workload{
+		Id:        store.ObjectID(),
+		Kind:      kind,
+		Group:     group,
+		Resource:  resource,
+		Name:      meta.Name,
+		Namespace: meta.Namespace,
+		UID:       string(meta.UID),
+		Template:  *template,
+		Ownership: store.ExtractOwnership(meta.Labels),
+		Runtime:   store.Runtime(c.runtime),
+	}
+
+	if owner := metav1.GetControllerOfNoCopy(meta); owner != nil {
+		output.OwnerUID = string(owner.UID)
+	}
+
+	return output
+}
+
+// Deployment returns the store representation of a K8s deployment.
+func (c *StoreConverter) Deployment(_ context.Context, input types.DeploymentType) (*store.Workload, error) {
+	return c.workload("Deployment", "apps", "deployments", &input.ObjectMeta, &input.Spec.Template), nil
+}
+
+// DaemonSet returns the store representation of a K8s daemon set.
+func (c *StoreConverter) DaemonSet(_ context.Context, input types.DaemonSetType) (*store.Workload, error) {
+	return c.workload("DaemonSet", "apps", "daemonsets", &input.ObjectMeta, &input.Spec.Template), nil
+}
+
+// StatefulSet returns the store representation of a K8s stateful set.
+func (c *StoreConverter) StatefulSet(_ context.Context, input types.StatefulSetType) (*store.Workload, error) {
+	return c.workload("StatefulSet", "apps", "statefulsets", &input.ObjectMeta, &input.Spec.Template), nil
+}
+
+// ReplicaSet returns the store representation of a K8s replica set.
+func (c *StoreConverter) ReplicaSet(_ context.Context, input types.ReplicaSetType) (*store.Workload, error) {
+	return c.workload("ReplicaSet", "apps", "replicasets", &input.ObjectMeta, &input.Spec.Template), nil
+}
+
+// Job returns the store representation of a K8s job.
+func (c *StoreConverter) Job(_ context.Context, input types.JobType) (*store.Workload, error) {
+	return c.workload("Job", "batch", "jobs", &input.ObjectMeta, &input.Spec.Template), nil
+}
+
+// CronJob returns the store representation of a K8s cron job.
+func (c *StoreConverter) CronJob(_ context.Context, input types.CronJobType) (*store.Workload, error) {
+	return c.workload("CronJob", "batch", "cronjobs", &input.ObjectMeta, &input.Spec.JobTemplate.Spec.Template), nil
+}
@@ -14,6 +14,7 @@ type Container struct {
 	IsNamespaced bool                  `json:"isNamespaced" mapstructure:"isNamespaced"`
 	Namespace    string                `json:"namespace" mapstructure:"namespace"`
 	Name         string                `json:"name" mapstructure:"name"`
+	Type         string                `json:"containerType" mapstructure:"containerType"`
 	Image        string                `json:"image" mapstructure:"image"`
 	Command      []string              `json:"command" mapstructure:"command"`
 	Args         []string              `json:"args" mapstructure:"args"`
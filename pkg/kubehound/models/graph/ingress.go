@@ -0,0 +1,17 @@
+package graph
+
+type Ingress struct {
+	StoreID      string   `json:"storeID" mapstructure:"storeID"`
+	App          string   `json:"app" mapstructure:"app"`
+	Team         string   `json:"team" mapstructure:"team"`
+	Service      string   `json:"service" mapstructure:"service"`
+	RunID        string   `json:"runID" mapstructure:"runID"`
+	Cluster      string   `json:"cluster" mapstructure:"cluster"`
+	IsNamespaced bool     `json:"isNamespaced" mapstructure:"isNamespaced"`
+	Namespace    string   `json:"namespace" mapstructure:"namespace"`
+	Name         string   `json:"name" mapstructure:"name"`
+	Type         string   `json:"type" mapstructure:"type"`
+	IngressClass string   `json:"ingressClass" mapstructure:"ingressClass"`
+	Hosts        []string `json:"hosts" mapstructure:"hosts"`
+	Gateways     []string `json:"gateways" mapstructure:"gateways"`
+}
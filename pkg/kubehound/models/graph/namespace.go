@@ -0,0 +1,14 @@
+package graph
+
+type Namespace struct {
+	StoreID            string   `json:"storeID" mapstructure:"storeID"`
+	App                string   `json:"app" mapstructure:"app"`
+	Team               string   `json:"team" mapstructure:"team"`
+	Service            string   `json:"service" mapstructure:"service"`
+	RunID              string   `json:"runID" mapstructure:"runID"`
+	Cluster            string   `json:"cluster" mapstructure:"cluster"`
+	Name               string   `json:"name" mapstructure:"name"`
+	Labels             []string `json:"labels" mapstructure:"labels"`
+	PodSecurityEnforce string   `json:"podSecurityEnforce" mapstructure:"podSecurityEnforce"`
+	PrivilegedBlocked  bool     `json:"privilegedBlocked" mapstructure:"privilegedBlocked"`
+}
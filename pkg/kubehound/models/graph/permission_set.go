@@ -13,5 +13,6 @@ type PermissionSet struct {
 	IsNamespaced bool     `json:"isNamespaced" mapstructure:"isNamespaced"`
 	Namespace    string   `json:"namespace" mapstructure:"namespace"`
 	Rules        []string `json:"rules" mapstructure:"rules"`
+	Aggregated   []string `json:"aggregated" mapstructure:"aggregated"`
 	Critical     bool     `json:"critical" mapstructure:"critical"`
 }
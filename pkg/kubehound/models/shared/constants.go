@@ -6,9 +6,10 @@ const (
 )
 
 const (
-	TokenTypeSA       = "ServiceAccount"
-	TokenTypeBoostrap = "Bootstrap"
-	TokenTypeOIDC     = "OIDC"
+	TokenTypeSA         = "ServiceAccount"
+	TokenTypeBoostrap   = "Bootstrap"
+	TokenTypeOIDC       = "OIDC"
+	TokenTypeKubeconfig = "Kubeconfig"
 )
 
 const (
@@ -17,6 +18,19 @@ const (
 	IdentityTypeGroup = "Group"
 )
 
+const (
+	ContainerTypeRegular   = "Regular"   // Container from the pod containers list
+	ContainerTypeInit      = "Init"      // Init container run to completion before the regular containers start
+	ContainerTypeSidecar   = "Sidecar"   // Init container with an Always restart policy, running alongside the regular containers
+	ContainerTypeEphemeral = "Ephemeral" // Ephemeral container added to a running pod (e.g kubectl debug)
+)
+
+const (
+	NetworkPolicyAccessOpen       = "Open"       // No ingress network policy restricts the traffic from the pods of the cluster
+	NetworkPolicyAccessRestricted = "Restricted" // Ingress network policies only allow traffic from selected peers
+	NetworkPolicyAccessDenied     = "Denied"     // Ingress network policies deny all traffic
+)
+
 type CompromiseType int
 
 const (
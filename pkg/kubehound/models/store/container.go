@@ -24,6 +24,7 @@ type Container struct {
 	Id        primitive.ObjectID `bson:"_id"`
 	PodId     primitive.ObjectID `bson:"pod_id"`
 	NodeId    primitive.ObjectID `bson:"node_id"`
+	Type      string             `bson:"type"`
 	Inherited ContainerInherited `bson:"inherited"`
 	K8        corev1.Container   `bson:"k8"`
 	Ownership OwnershipInfo      `bson:"ownership"`
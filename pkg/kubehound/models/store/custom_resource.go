@@ -0,0 +1,24 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CustomResource holds an object of a custom resource configured for collection (e.g an Argo CD application). The
+// object is stored as is, without a dedicated type, with the metadata required to query it from the edge builders.
+type CustomResource struct {
+	Id        primitive.ObjectID `bson:"_id"`
+	Group     string             `bson:"group"`      // API group of the resource (e.g argoproj.io)
+	Version   string             `bson:"version"`    // API version of the object (e.g v1alpha1)
+	Resource  string             `bson:"resource"`   // Resource name as used in RBAC rules (e.g applications)
+	Kind      string             `bson:"kind"`       // Object kind (e.g Application)
+	Name      string             `bson:"name"`       // Object name
+	Namespace string             `bson:"namespace"`  // Object namespace (empty for cluster scoped objects)
+	UID       string             `bson:"uid"`        // Object UID
+	OwnerKind string             `bson:"owner_kind"` // Kind of the controller managing the object, if any
+	OwnerName string             `bson:"owner_name"` // Name of the controller managing the object, if any
+	OwnerUID  string             `bson:"owner_uid"`  // UID of the controller managing the object, if any
+	K8        map[string]any     `bson:"k8"`         // Unstructured content of the object
+	Ownership OwnershipInfo      `bson:"ownership"`
+	Runtime   RuntimeInfo        `bson:"runtime"`
+}
@@ -0,0 +1,16 @@
+package store
+
+import (
+	userv1 "github.com/openshift/api/user/v1"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Group is an explicit OpenShift group (user.openshift.io) listing the users it is made of.
+type Group struct {
+	Id        primitive.ObjectID `bson:"_id"`
+	Name      string             `bson:"name"`
+	Users     []string           `bson:"users"`
+	K8        userv1.Group       `bson:"k8"`
+	Ownership OwnershipInfo      `bson:"ownership"`
+	Runtime   RuntimeInfo        `bson:"runtime"`
+}
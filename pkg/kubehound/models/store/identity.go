@@ -10,6 +10,7 @@ type Identity struct {
 	IsNamespaced bool               `bson:"is_namespaced"`
 	Namespace    string             `bson:"namespace"`
 	Type         string             `bson:"type"`
+	Groups       []string           `bson:"groups"` // Groups the identity is implicitly a member of (e.g system:authenticated)
 	Ownership    OwnershipInfo      `bson:"ownership"`
 	Runtime      RuntimeInfo        `bson:"runtime"`
 }
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"github.com/DataDog/KubeHound/pkg/globals/types/gatewayapi"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	IngressTypeIngress   = "Ingress"
+	IngressTypeHTTPRoute = "HTTPRoute"
+)
+
+// IngressBackend is a service port targeted by an ingress or HTTP route. The port is referenced either by number or by
+// name depending on the source object.
+type IngressBackend struct {
+	Namespace   string `bson:"namespace"`
+	ServiceName string `bson:"service_name"`
+	Port        int32  `bson:"port"`
+	PortName    string `bson:"port_name"`
+}
+
+// Ingress is a traffic entry point into the cluster, sourced either from an ingress or a Gateway API HTTP route.
+type Ingress struct {
+	Id           primitive.ObjectID `bson:"_id"`
+	Type         string             `bson:"type"`
+	IsNamespaced bool               `bson:"is_namespaced"`
+	Namespace    string             `bson:"namespace"`
+	Name         string             `bson:"name"`
+	Class        string             `bson:"class"`    // Ingress class or gateway class of the parent gateways
+	Hosts        []string           `bson:"hosts"`    // Hostnames matched by the entry point
+	Gateways     []string           `bson:"gateways"` // Parent gateways of an HTTP route as namespace/name
+	Backends     []IngressBackend   `bson:"backends"`
+	K8           metav1.ObjectMeta  `bson:"k8"`
+	Ownership    OwnershipInfo      `bson:"ownership"`
+	Runtime      RuntimeInfo        `bson:"runtime"`
+}
+
+// Gateway is a Gateway API gateway whose listeners provide the hostnames of the HTTP routes attached to it.
+type Gateway struct {
+	Id           primitive.ObjectID `bson:"_id"`
+	IsNamespaced bool               `bson:"is_namespaced"`
+	Namespace    string             `bson:"namespace"`
+	Name         string             `bson:"name"`
+	K8           gatewayapi.Gateway `bson:"k8"`
+	Ownership    OwnershipInfo      `bson:"ownership"`
+	Runtime      RuntimeInfo        `bson:"runtime"`
+}
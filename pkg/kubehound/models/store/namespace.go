@@ -0,0 +1,16 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type Namespace struct {
+	Id                primitive.ObjectID `bson:"_id"`
+	Name              string             `bson:"name"`
+	EnforceLevel      string             `bson:"enforce_level"`      // Pod Security Admission enforce level
+	PrivilegedBlocked bool               `bson:"privileged_blocked"` // Whether the enforce level blocks privileged pods
+	K8                corev1.Namespace   `bson:"k8"`
+	Ownership         OwnershipInfo      `bson:"ownership"`
+	Runtime           RuntimeInfo        `bson:"runtime"`
+}
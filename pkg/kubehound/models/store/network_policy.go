@@ -0,0 +1,15 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type NetworkPolicy struct {
+	Id        primitive.ObjectID         `bson:"_id"`
+	Name      string                     `bson:"name"`
+	Namespace string                     `bson:"namespace"`
+	K8        networkingv1.NetworkPolicy `bson:"k8"`
+	Ownership OwnershipInfo              `bson:"ownership"`
+	Runtime   RuntimeInfo                `bson:"runtime"`
+}
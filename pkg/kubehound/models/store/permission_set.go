@@ -5,6 +5,16 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
+// Permission is a single (group, resource, subresource, verb) action granted by the rules of a permission set, with
+// the RBAC wildcards resolved against the API discovery of the cluster.
+type Permission struct {
+	Group         string   `bson:"group"`
+	Resource      string   `bson:"resource"`
+	Subresource   string   `bson:"subresource"`
+	Verb          string   `bson:"verb"`
+	ResourceNames []string `bson:"resource_names"` // Objects the permission is restricted to, empty for all objects
+}
+
 type PermissionSet struct {
 	Id              primitive.ObjectID  `bson:"_id"`
 	RoleId          primitive.ObjectID  `bson:"role_id"`
@@ -15,6 +25,8 @@ type PermissionSet struct {
 	IsNamespaced    bool                `bson:"is_namespaced"`
 	Namespace       string              `bson:"namespace"`
 	Rules           []rbacv1.PolicyRule `bson:"rules"`
+	Permissions     []Permission        `bson:"permissions"`
+	Aggregated      []string            `bson:"aggregated"` // Cluster roles the rules were aggregated from, if any
 	Ownership       OwnershipInfo       `bson:"ownership"`
 	Runtime         RuntimeInfo         `bson:"runtime"`
 }
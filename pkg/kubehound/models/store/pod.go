@@ -8,6 +8,7 @@ import (
 type Pod struct {
 	Id           primitive.ObjectID `bson:"_id"`
 	NodeId       primitive.ObjectID `bson:"node_id"`
+	OwnerId      primitive.ObjectID `bson:"owner_id"` // Store ID of the controlling workload, if collected
 	IsNamespaced bool               `bson:"is_namespaced"`
 	K8           corev1.Pod         `bson:"k8"`
 	Ownership    OwnershipInfo      `bson:"ownership"`
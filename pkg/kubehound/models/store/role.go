@@ -11,6 +11,7 @@ type Role struct {
 	IsNamespaced bool                `bson:"is_namespaced"`
 	Namespace    string              `bson:"namespace"`
 	Rules        []rbacv1.PolicyRule `bson:"rules"`
+	Aggregated   []string            `bson:"aggregated"` // Cluster roles the rules were aggregated from, if any
 	Ownership    OwnershipInfo       `bson:"ownership"`
 	Runtime      RuntimeInfo         `bson:"runtime"`
 }
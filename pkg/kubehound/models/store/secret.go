@@ -0,0 +1,23 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Secret holds the metadata of a K8s secret. The secret data is never stored, so the identity a secret authenticates
+// as is derived from its type and annotations only (kubeconfig identities are resolved by the collector).
+type Secret struct {
+	Id                primitive.ObjectID `bson:"_id"`
+	Name              string             `bson:"name"`
+	Namespace         string             `bson:"namespace"`
+	Type              corev1.SecretType  `bson:"type"`
+	TokenType         string             `bson:"token_type"`         // Type of the token held by the secret (see shared.TokenTypeXXX), empty if not resolved
+	Identity          string             `bson:"identity"`           // User or service account the token authenticates as
+	IdentityNamespace string             `bson:"identity_namespace"` // Namespace of the service account the token authenticates as
+	Groups            []string           `bson:"groups"`             // Groups implicitly granted to the token
+	K8                metav1.ObjectMeta  `bson:"k8"`
+	Ownership         OwnershipInfo      `bson:"ownership"`
+	Runtime           RuntimeInfo        `bson:"runtime"`
+}
@@ -0,0 +1,17 @@
+package store
+
+import (
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type Service struct {
+	Id        primitive.ObjectID          `bson:"_id"`
+	Name      string                      `bson:"name"`
+	Namespace string                      `bson:"namespace"`
+	Exposure  shared.EndpointExposureType `bson:"access"` // Level of exposure of the endpoints backing the service
+	K8        corev1.Service              `bson:"k8"`
+	Ownership OwnershipInfo               `bson:"ownership"`
+	Runtime   RuntimeInfo                 `bson:"runtime"`
+}
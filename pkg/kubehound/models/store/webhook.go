@@ -0,0 +1,30 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WebhookService references the in-cluster service serving an admission webhook.
+type WebhookService struct {
+	Namespace string `bson:"namespace"`
+	Name      string `bson:"name"`
+	Port      int32  `bson:"port"`
+}
+
+// Webhook holds a single admission webhook of a mutating or validating webhook configuration.
+type Webhook struct {
+	Id                primitive.ObjectID    `bson:"_id"`
+	Kind              string                `bson:"kind"`               // Kind of the parent configuration (e.g MutatingWebhookConfiguration)
+	Configuration     string                `bson:"configuration"`      // Name of the parent configuration
+	Name              string                `bson:"name"`               // Name of the webhook within the configuration
+	Service           *WebhookService       `bson:"service"`            // Service serving the webhook, if not called via an URL
+	URL               string                `bson:"url"`                // URL of the webhook, if not served by a service
+	TargetsPods       bool                  `bson:"targets_pods"`       // Whether the rules of the webhook intercept pod creations or updates
+	FailurePolicy     string                `bson:"failure_policy"`     // Behaviour when the webhook cannot be called
+	NamespaceSelector *metav1.LabelSelector `bson:"namespace_selector"` // Selector on the namespace of the intercepted objects
+	ObjectSelector    *metav1.LabelSelector `bson:"object_selector"`    // Selector on the labels of the intercepted objects
+	K8                metav1.ObjectMeta     `bson:"k8"`                 // Metadata of the parent configuration
+	Ownership         OwnershipInfo         `bson:"ownership"`
+	Runtime           RuntimeInfo           `bson:"runtime"`
+}
@@ -0,0 +1,22 @@
+package store
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Workload holds a workload controller (deployment, daemon set, stateful set, replica set, job or cron job) managing
+// pods from a pod template.
+type Workload struct {
+	Id        primitive.ObjectID     `bson:"_id"`
+	Kind      string                 `bson:"kind"`      // Object kind (e.g DaemonSet)
+	Group     string                 `bson:"group"`     // API group of the workload resource (e.g apps)
+	Resource  string                 `bson:"resource"`  // Workload resource name as used in RBAC rules (e.g daemonsets)
+	Name      string                 `bson:"name"`      // Object name
+	Namespace string                 `bson:"namespace"` // Object namespace
+	UID       string                 `bson:"uid"`       // Object UID
+	OwnerUID  string                 `bson:"owner_uid"` // UID of the controller managing the workload (e.g the deployment of a replica set), if any
+	Template  corev1.PodTemplateSpec `bson:"template"`  // Template of the pods managed by the workload
+	Ownership OwnershipInfo          `bson:"ownership"`
+	Runtime   RuntimeInfo            `bson:"runtime"`
+}
@@ -0,0 +1,31 @@
+package cachekey
+
+import (
+	"strings"
+)
+
+const (
+	serviceCacheName = "k8s-service"
+)
+
+type serviceCacheKey struct {
+	baseCacheKey
+}
+
+var _ CacheKey = (*serviceCacheKey)(nil) // Ensure interface compliance
+
+func Service(serviceName string, namespace string) *serviceCacheKey {
+	var sb strings.Builder
+
+	sb.WriteString(namespace)
+	sb.WriteString(CacheKeySeparator)
+	sb.WriteString(serviceName)
+
+	return &serviceCacheKey{
+		baseCacheKey{sb.String()},
+	}
+}
+
+func (k *serviceCacheKey) Shard() string {
+	return serviceCacheName
+}
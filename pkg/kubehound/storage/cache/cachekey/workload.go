@@ -0,0 +1,33 @@
+package cachekey
+
+import (
+	"strings"
+)
+
+const (
+	workloadCacheName = "k8s-workload"
+)
+
+type workloadCacheKey struct {
+	baseCacheKey
+}
+
+var _ CacheKey = (*workloadCacheKey)(nil) // Ensure interface compliance
+
+func Workload(kind string, workloadName string, namespace string) *workloadCacheKey {
+	var sb strings.Builder
+
+	sb.WriteString(namespace)
+	sb.WriteString(CacheKeySeparator)
+	sb.WriteString(kind)
+	sb.WriteString(CacheKeySeparator)
+	sb.WriteString(workloadName)
+
+	return &workloadCacheKey{
+		baseCacheKey{sb.String()},
+	}
+}
+
+func (k *workloadCacheKey) Shard() string {
+	return workloadCacheName
+}
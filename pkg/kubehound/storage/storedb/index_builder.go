@@ -29,14 +29,30 @@ func (ib *IndexBuilder) BuildAll(ctx context.Context) error {
 		return fmt.Errorf("build container indices: %w", err)
 	}
 
+	if err := ib.customResources(ctx); err != nil {
+		return fmt.Errorf("build custom resource indices: %w", err)
+	}
+
 	if err := ib.endpoints(ctx); err != nil {
 		return fmt.Errorf("build endpoint indices: %w", err)
 	}
 
+	if err := ib.groups(ctx); err != nil {
+		return fmt.Errorf("build group indices: %w", err)
+	}
+
+	if err := ib.ingresses(ctx); err != nil {
+		return fmt.Errorf("build ingress indices: %w", err)
+	}
+
 	if err := ib.identities(ctx); err != nil {
 		return fmt.Errorf("build identity indices: %w", err)
 	}
 
+	if err := ib.namespaces(ctx); err != nil {
+		return fmt.Errorf("build namespace indices: %w", err)
+	}
+
 	if err := ib.nodes(ctx); err != nil {
 		return fmt.Errorf("build node indices: %w", err)
 	}
@@ -49,10 +65,22 @@ func (ib *IndexBuilder) BuildAll(ctx context.Context) error {
 		return fmt.Errorf("build pod indices: %w", err)
 	}
 
+	if err := ib.secrets(ctx); err != nil {
+		return fmt.Errorf("build secret indices: %w", err)
+	}
+
+	if err := ib.services(ctx); err != nil {
+		return fmt.Errorf("build service indices: %w", err)
+	}
+
 	if err := ib.volumes(ctx); err != nil {
 		return fmt.Errorf("build volume indices: %w", err)
 	}
 
+	if err := ib.workloads(ctx); err != nil {
+		return fmt.Errorf("build workload indices: %w", err)
+	}
+
 	return nil
 }
 
@@ -84,6 +112,10 @@ func (ib *IndexBuilder) containers(ctx context.Context) error {
 			Keys:    bson.M{"inherited.host_pid": 1},
 			Options: options.Index().SetName("byHostPid"),
 		},
+		{
+			Keys:    bson.M{"inherited.host_net": 1},
+			Options: options.Index().SetName("byHostNetwork"),
+		},
 		{
 			Keys:    bson.M{"inherited.service_account": 1},
 			Options: options.Index().SetName("bySA"),
@@ -107,6 +139,32 @@ func (ib *IndexBuilder) containers(ctx context.Context) error {
 	return err
 }
 
+// customResources builds the store indices for the custom resources collection.
+func (ib *IndexBuilder) customResources(ctx context.Context) error {
+	customResources := ib.db.Collection(collections.CustomResourceName)
+	indices := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group", Value: 1},
+				{Key: "kind", Value: 1},
+			},
+			Options: options.Index().SetName("byGroupKind"),
+		},
+		{
+			Keys:    bson.M{"namespace": 1},
+			Options: options.Index().SetName("byNamespace"),
+		},
+		{
+			Keys:    bson.M{"owner_uid": 1},
+			Options: options.Index().SetName("byOwnerUID"),
+		},
+	}
+
+	_, err := customResources.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
 // endpoints builds the store indices for the endpoints collection.
 func (ib *IndexBuilder) endpoints(ctx context.Context) error {
 	endpoints := ib.db.Collection(collections.EndpointName)
@@ -115,6 +173,24 @@ func (ib *IndexBuilder) endpoints(ctx context.Context) error {
 			Keys:    bson.M{"has_slice": 1},
 			Options: options.Index().SetName("bySliceSet"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "has_slice", Value: 1},
+				{Key: "access", Value: 1},
+			},
+			Options: options.Index().SetName("bySliceSetExposure"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "namespace", Value: 1},
+				{Key: "service_name", Value: 1},
+			},
+			Options: options.Index().SetName("byService"),
+		},
+		{
+			Keys:    bson.M{"node_name": 1},
+			Options: options.Index().SetName("byNode"),
+		},
 	}
 
 	_, err := endpoints.Indexes().CreateMany(ctx, indices)
@@ -122,6 +198,40 @@ func (ib *IndexBuilder) endpoints(ctx context.Context) error {
 	return err
 }
 
+// groups builds the store indices for the groups collection.
+func (ib *IndexBuilder) groups(ctx context.Context) error {
+	groups := ib.db.Collection(collections.GroupName)
+	indices := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"name": 1},
+			Options: options.Index().SetName("byName"),
+		},
+		{
+			Keys:    bson.M{"users": 1},
+			Options: options.Index().SetName("byUsers"),
+		},
+	}
+
+	_, err := groups.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
+// ingresses builds the store indices for the ingresses collection.
+func (ib *IndexBuilder) ingresses(ctx context.Context) error {
+	ingresses := ib.db.Collection(collections.IngressName)
+	indices := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"namespace": 1},
+			Options: options.Index().SetName("byNamespace"),
+		},
+	}
+
+	_, err := ingresses.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
 // identities builds the store indices for the identities collection.
 func (ib *IndexBuilder) identities(ctx context.Context) error {
 	identities := ib.db.Collection(collections.IdentityName)
@@ -150,6 +260,10 @@ func (ib *IndexBuilder) identities(ctx context.Context) error {
 			},
 			Options: options.Index().SetName("byLookupFields"),
 		},
+		{
+			Keys:    bson.M{"groups": 1},
+			Options: options.Index().SetName("byGroups"),
+		},
 	}
 
 	_, err := identities.Indexes().CreateMany(ctx, indices)
@@ -157,6 +271,21 @@ func (ib *IndexBuilder) identities(ctx context.Context) error {
 	return err
 }
 
+// namespaces builds the store indices for the namespaces collection.
+func (ib *IndexBuilder) namespaces(ctx context.Context) error {
+	namespaces := ib.db.Collection(collections.NamespaceName)
+	indices := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"name": 1},
+			Options: options.Index().SetName("byName"),
+		},
+	}
+
+	_, err := namespaces.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
 // nodes builds the store indices for the nodes collection.
 func (ib *IndexBuilder) nodes(ctx context.Context) error {
 	nodes := ib.db.Collection(collections.NodeName)
@@ -185,19 +314,16 @@ func (ib *IndexBuilder) permissionsets(ctx context.Context) error {
 			Options: options.Index().SetName("byNamespace"),
 		},
 		{
-			Keys:    bson.M{"rules.apigroups": 1},
-			Options: options.Index().SetName("byApiGroup"),
-		},
-		{
-			Keys:    bson.M{"rules.resources": 1},
-			Options: options.Index().SetName("byResources"),
-		},
-		{
-			Keys:    bson.M{"rules.verbs": 1},
-			Options: options.Index().SetName("byVerbs"),
+			Keys: bson.D{
+				{Key: "permissions.group", Value: 1},
+				{Key: "permissions.resource", Value: 1},
+				{Key: "permissions.subresource", Value: 1},
+				{Key: "permissions.verb", Value: 1},
+			},
+			Options: options.Index().SetName("byPermission"),
 		},
 		{
-			Keys:    bson.M{"rules.resourcenames": 1},
+			Keys:    bson.M{"permissions.resource_names": 1},
 			Options: options.Index().SetName("byResourceNames"),
 		},
 	}
@@ -223,6 +349,10 @@ func (ib *IndexBuilder) pods(ctx context.Context) error {
 			Keys:    bson.M{"k8.objectmeta.namespace": 1},
 			Options: options.Index().SetName("byNamespace"),
 		},
+		{
+			Keys:    bson.M{"owner_id": 1},
+			Options: options.Index().SetName("byOwner"),
+		},
 	}
 
 	_, err := pods.Indexes().CreateMany(ctx, indices)
@@ -230,6 +360,43 @@ func (ib *IndexBuilder) pods(ctx context.Context) error {
 	return err
 }
 
+// secrets builds the store indices for the secrets collection.
+func (ib *IndexBuilder) secrets(ctx context.Context) error {
+	secrets := ib.db.Collection(collections.SecretName)
+	indices := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"namespace": 1},
+			Options: options.Index().SetName("byNamespace"),
+		},
+		{
+			Keys:    bson.M{"token_type": 1},
+			Options: options.Index().SetName("byTokenType"),
+		},
+	}
+
+	_, err := secrets.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
+// services builds the store indices for the services collection.
+func (ib *IndexBuilder) services(ctx context.Context) error {
+	services := ib.db.Collection(collections.ServiceName)
+	indices := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "namespace", Value: 1},
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetName("byNamespaceName"),
+		},
+	}
+
+	_, err := services.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
+
 // volumes builds the store indices for the volumes collection.
 func (ib *IndexBuilder) volumes(ctx context.Context) error {
 	volumes := ib.db.Collection(collections.VolumeName)
@@ -291,3 +458,26 @@ func (ib *IndexBuilder) volumes(ctx context.Context) error {
 
 	return err
 }
+
+// workloads builds the store indices for the workloads collection.
+func (ib *IndexBuilder) workloads(ctx context.Context) error {
+	workloads := ib.db.Collection(collections.WorkloadName)
+	indices := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"namespace": 1},
+			Options: options.Index().SetName("byNamespace"),
+		},
+		{
+			Keys:    bson.M{"uid": 1},
+			Options: options.Index().SetName("byUID"),
+		},
+		{
+			Keys:    bson.M{"owner_uid": 1},
+			Options: options.Index().SetName("byOwnerUID"),
+		},
+	}
+
+	_, err := workloads.Indexes().CreateMany(ctx, indices)
+
+	return err
+}
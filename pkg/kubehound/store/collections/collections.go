@@ -5,16 +5,26 @@ const (
 )
 
 const (
-	NodeName          = "nodes"
-	PodName           = "pods"
-	ContainerName     = "containers"
-	VolumeName        = "volumes"
-	RoleName          = "roles"
-	RoleBindingName   = "rolebindings"
-	IdentityName      = "identities"
-	PermissionSetName = "permissionsets"
-	EndpointName      = "endpoints"
-	RouteName         = "routes"
+	NodeName           = "nodes"
+	PodName            = "pods"
+	ContainerName      = "containers"
+	VolumeName         = "volumes"
+	RoleName           = "roles"
+	RoleBindingName    = "rolebindings"
+	IdentityName       = "identities"
+	PermissionSetName  = "permissionsets"
+	EndpointName       = "endpoints"
+	RouteName          = "routes"
+	NamespaceName      = "namespaces"
+	WorkloadName       = "workloads"
+	ServiceName        = "services"
+	IngressName        = "ingresses"
+	GatewayName        = "gateways"
+	NetworkPolicyName  = "networkpolicies"
+	SecretName         = "secrets"
+	WebhookName        = "webhooks"
+	CustomResourceName = "customresources"
+	GroupName          = "groups"
 )
 
 // Collection provides a common abstraction of a SQL database table or a NoSQL object
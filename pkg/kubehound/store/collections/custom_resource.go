@@ -0,0 +1,14 @@
+package collections
+
+type CustomResource struct {
+}
+
+var _ Collection = (*CustomResource)(nil) // Ensure interface compliance
+
+func (c CustomResource) Name() string {
+	return CustomResourceName
+}
+
+func (c CustomResource) BatchSize() int {
+	return DefaultBatchSize
+}
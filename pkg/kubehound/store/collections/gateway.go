@@ -0,0 +1,14 @@
+package collections
+
+type Gateway struct {
+}
+
+var _ Collection = (*Gateway)(nil) // Ensure interface compliance
+
+func (c Gateway) Name() string {
+	return GatewayName
+}
+
+func (c Gateway) BatchSize() int {
+	return DefaultBatchSize
+}
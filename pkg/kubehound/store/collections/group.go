@@ -0,0 +1,14 @@
+package collections
+
+type Group struct {
+}
+
+var _ Collection = (*Group)(nil) // Ensure interface compliance
+
+func (c Group) Name() string {
+	return GroupName
+}
+
+func (c Group) BatchSize() int {
+	return DefaultBatchSize
+}
@@ -0,0 +1,14 @@
+package collections
+
+type Ingress struct {
+}
+
+var _ Collection = (*Ingress)(nil) // Ensure interface compliance
+
+func (c Ingress) Name() string {
+	return IngressName
+}
+
+func (c Ingress) BatchSize() int {
+	return DefaultBatchSize
+}
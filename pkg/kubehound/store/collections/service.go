@@ -0,0 +1,14 @@
+package collections
+
+type Service struct {
+}
+
+var _ Collection = (*Service)(nil) // Ensure interface compliance
+
+func (c Service) Name() string {
+	return ServiceName
+}
+
+func (c Service) BatchSize() int {
+	return DefaultBatchSize
+}
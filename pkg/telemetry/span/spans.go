@@ -5,6 +5,7 @@ const (
 	IngestData = "kubehound.ingestData"
 	BuildGraph = "kubehound.buildGraph"
 	Launch     = "kubehound.launch"
+	Dump       = "kubehound.dump"
 )
 
 // JanusGraph provider spans
@@ -23,6 +24,7 @@ const (
 const (
 	CollectorStream   = "kubehound.collector.stream"
 	CollectorReadFile = "kubehound.collector.readFile"
+	CollectorDump     = "kubehound.collector.dump"
 )
 
 // Graph builder spans
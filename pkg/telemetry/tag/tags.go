@@ -26,7 +26,25 @@ const (
 	EntityEndpoints           = "endpoints"
 	EntityClusterRoles        = "clusterroles"
 	EntityClusterRolebindings = "clusterrolebindings"
+	EntityNamespaces          = "namespaces"
+	EntityServices            = "services"
+	EntityWorkloads           = "workloads"
+	EntityDeployments         = "deployments"
+	EntityDaemonSets          = "daemonsets"
+	EntityStatefulSets        = "statefulsets"
+	EntityReplicaSets         = "replicasets"
+	EntityJobs                = "jobs"
+	EntityCronJobs            = "cronjobs"
+	EntityIngresses           = "ingresses"
+	EntityGateways            = "gateways"
+	EntityHTTPRoutes          = "httproutes"
+	EntityNetworkPolicies     = "networkpolicies"
+	EntitySecrets             = "secrets"
+	EntityMutatingWebhooks    = "mutatingwebhookconfigurations"
+	EntityValidatingWebhooks  = "validatingwebhookconfigurations"
+	EntityCustomResources     = "customresources"
 	EntityRoutes              = "routes" // OpenShift-specific
+	EntityGroups              = "groups" // OpenShift-specific
 )
 
 var (
@@ -22,6 +22,10 @@ namespaces=(
     'default'
     'vault'
     'dev'
+    'resource-names'
+    'container-types'
+    'impersonate'
+    'net-mitm'
 )
 
 # Project vars
@@ -38,11 +42,26 @@ function handle_resources(){
         [ -e "$attack" ] || continue
         _printf_ok "$attack"
 
+        if [ "$1" == "apply" ] && grep -q "ephemeralContainers:" "$attack"; then
+            apply_ephemeral_containers "$attack"
+            continue
+        fi
+
         # since deletion can take some times, || true to be able to retry in case of C-C
         kubectl $1 -f "$attack" --context "kind-${CLUSTER_NAME}" || true
     done
 }
 
+# Ephemeral containers cannot be set on pod creation: create the pod without them, then add them through the
+# ephemeralcontainers subresource (the manifest must hold a single pod)
+function apply_ephemeral_containers(){
+    local attack=$1
+
+    kubectl patch --local -f "$attack" --type json -p '[{"op": "remove", "path": "/spec/ephemeralContainers"}]' -o yaml \
+        | kubectl apply -f - --context "kind-${CLUSTER_NAME}" || true
+    kubectl replace --subresource ephemeralcontainers -f "$attack" --context "kind-${CLUSTER_NAME}" || true
+}
+
 function create_namespace(){
     local namespace=$1
     # Creating namespace only if not defined
@@ -15,8 +15,10 @@ import (
 	"time"
 
 	"github.com/DataDog/KubeHound/pkg/config"
+	"github.com/DataDog/KubeHound/pkg/kubehound/libkube"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/converter"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/graph"
+	"github.com/DataDog/KubeHound/pkg/kubehound/models/shared"
 	"github.com/DataDog/KubeHound/pkg/kubehound/models/store"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache"
 	"github.com/DataDog/KubeHound/pkg/kubehound/storage/cache/cachekey"
@@ -232,7 +234,22 @@ func ProcessFile(ctx context.Context, basePath string, file os.FileInfo, cacheRo
 			}
 
 			for _, cont := range o.Spec.Containers {
-				err = AddContainerToList(&cont, &p)
+				err = AddContainerToList(&cont, shared.ContainerTypeRegular, &p)
+				if err != nil {
+					fmt.Println("Failed to add container to list:", err)
+				}
+
+				for _, vol := range cont.VolumeMounts {
+					err = AddVolumeToList(&vol, &p)
+					if err != nil {
+						fmt.Println("Failed to add volume to list:", err)
+					}
+				}
+
+			}
+
+			for _, cont := range o.Spec.InitContainers {
+				err = AddContainerToList(&cont, libkube.InitContainerType(&cont), &p)
 				if err != nil {
 					fmt.Println("Failed to add container to list:", err)
 				}
@@ -243,7 +260,21 @@ func ProcessFile(ctx context.Context, basePath string, file os.FileInfo, cacheRo
 						fmt.Println("Failed to add volume to list:", err)
 					}
 				}
+			}
 
+			for _, ephemeral := range o.Spec.EphemeralContainers {
+				cont := corev1.Container(ephemeral.EphemeralContainerCommon)
+				err = AddContainerToList(&cont, shared.ContainerTypeEphemeral, &p)
+				if err != nil {
+					fmt.Println("Failed to add container to list:", err)
+				}
+
+				for _, vol := range cont.VolumeMounts {
+					err = AddVolumeToList(&vol, &p)
+					if err != nil {
+						fmt.Println("Failed to add volume to list:", err)
+					}
+				}
 			}
 
 		case *rbacv1.Role:
@@ -376,10 +407,10 @@ func AddNodeToList(node *corev1.Node) error {
 	return nil
 }
 
-func AddContainerToList(Container *corev1.Container, storePod *store.Pod) error {
+func AddContainerToList(Container *corev1.Container, containerType string, storePod *store.Pod) error {
 	fmt.Printf("Container name: %s\n", Container.Name)
 	convStore := converter.NewStore(GeneratorConfig)
-	storeContainer, err := convStore.Container(context.Background(), Container, storePod)
+	storeContainer, err := convStore.Container(context.Background(), Container, containerType, storePod)
 	if err != nil {
 		return err
 	}
@@ -507,6 +538,7 @@ func GenerateContainerTemplate() ([]byte, error) {
 		"{{.Name}}": {
 			StoreID:      "",
 			Name:         "{{.Name}}",
+			Type:         "{{.Type}}",
 			Image:        "{{.Image}}",
 			Command:      []string{},
 			Args:         []string{},
@@ -116,6 +116,25 @@ func (suite *DslTestSuite) TestTraversalSource_escapes() {
 		"path[kube-proxy, CE_MODULE_LOAD, Node]",
 		"path[kube-proxy, CE_PRIV_MOUNT, Node]",
 		"path[varlog-container, CE_VAR_LOG_SYMLINK, Node]",
+		"path[kube-proxy, CE_UMH_CORE_PATTERN, Node]",
+		"path[kube-proxy, CE_UMH_CORE_PATTERN, Node]",
+		"path[kube-proxy, CE_UMH_CORE_PATTERN, Node]",
+		"path[priv-pod, CE_UMH_CORE_PATTERN, Node]",
+		"path[nsenter-pod, CE_UMH_CORE_PATTERN, Node]",
+		"path[endpoints-pod, CE_UMH_CORE_PATTERN, Node]",
+		"path[umh-core-pod, CE_UMH_CORE_PATTERN, Node]",
+		"path[host-write-exploit-pod, CE_UMH_CORE_PATTERN, Node]",
+		"path[containerd-sock-pod, EXPLOIT_CONTAINERD_SOCK, Node]",
+		"path[host-write-exploit-pod, EXPLOIT_CONTAINERD_SOCK, Node]",
+		"path[container-types-init, CE_NSENTER, Node]",
+		"path[container-types-init, CE_MODULE_LOAD, Node]",
+		"path[container-types-init, CE_PRIV_MOUNT, Node]",
+		"path[container-types-sidecar, CE_NSENTER, Node]",
+		"path[container-types-sidecar, CE_MODULE_LOAD, Node]",
+		"path[container-types-sidecar, CE_PRIV_MOUNT, Node]",
+		"path[container-types-debugger, CE_NSENTER, Node]",
+		"path[container-types-debugger, CE_MODULE_LOAD, Node]",
+		"path[container-types-debugger, CE_PRIV_MOUNT, Node]",
 	}
 
 	suite.ElementsMatch(escapes, expected)
@@ -124,7 +143,7 @@ func (suite *DslTestSuite) TestTraversalSource_escapes() {
 func (suite *DslTestSuite) TestTraversalSource_endpoints() {
 	eps := suite.testScriptArray("kh.endpoints().has('namespace', 'default').values('portName')")
 	expected := []string{
-		"jmx", "host-port-svc", "webproxy-service-port",
+		"jmx", "host-port-svc", "webproxy-service-port", "internal-service-port",
 	}
 
 	suite.ElementsMatch(eps, expected)
@@ -247,6 +266,7 @@ func (suite *DslTestSuite) TestTraversal_attacks() {
 		"path[nsenter-pod, CE_NSENTER, Node]",
 		"path[nsenter-pod, CE_MODULE_LOAD, Node]",
 		"path[nsenter-pod, CE_PRIV_MOUNT, Node]",
+		"path[nsenter-pod, CE_UMH_CORE_PATTERN, Node]",
 	}
 
 	suite.ElementsMatch(attacks, expected)
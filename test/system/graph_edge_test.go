@@ -115,6 +115,24 @@ func (suite *EdgeTestSuite) TestEdge_CE_NSENTER() {
 	suite._testContainerEscape("CE_NSENTER", DefaultContainerEscapeNodes, containers)
 }
 
+func (suite *EdgeTestSuite) TestEdge_CE_NSENTER_ContainerTypes() {
+	// Init, sidecar and ephemeral containers inherit the host PID namespace of their pod (only the regular container of
+	// the pod is not privileged)
+	results, err := suite.g.V().
+		HasLabel("Container").
+		Has("namespace", "container-types").
+		Where(__.OutE("CE_NSENTER")).
+		Values("name").
+		ToList()
+
+	suite.NoError(err)
+	suite.ElementsMatch(suite.resultsToStringArray(results), []string{
+		"container-types-init",
+		"container-types-sidecar",
+		"container-types-debugger",
+	})
+}
+
 func (suite *EdgeTestSuite) TestEdge_CE_PRIV_MOUNT() {
 	containers := map[string]bool{
 		"priv-pod": true,
@@ -131,6 +149,147 @@ func (suite *EdgeTestSuite) TestEdge_CE_SYS_PTRACE() {
 	suite._testContainerEscape("CE_SYS_PTRACE", DefaultContainerEscapeNodes, containers)
 }
 
+func (suite *EdgeTestSuite) TestEdge_CE_UMH_CORE_PATTERN() {
+	containers := map[string]bool{
+		"umh-core-pod": true,
+	}
+
+	suite._testContainerEscape("CE_UMH_CORE_PATTERN", DefaultContainerEscapeNodes, containers)
+}
+
+func (suite *EdgeTestSuite) TestEdge_CE_UMH_CORE_PATTERN_Privileged() {
+	// Privileged containers can write the (non namespaced) core_pattern setting directly
+	containers := map[string]bool{
+		"priv-pod": true,
+	}
+
+	suite._testContainerEscape("CE_UMH_CORE_PATTERN", DefaultContainerEscapeNodes, containers)
+}
+
+func (suite *EdgeTestSuite) TestEdge_EXPLOIT_CONTAINERD_SOCK() {
+	containers := map[string]bool{
+		"containerd-sock-pod": true,
+	}
+
+	suite._testContainerEscape("EXPLOIT_CONTAINERD_SOCK", DefaultContainerEscapeNodes, containers)
+}
+
+func (suite *EdgeTestSuite) TestEdge_CE_NET_MITM() {
+	// Find the containers on the same node as our host network pod
+	results, err := suite.g.V().
+		HasLabel("Container").
+		Has("name", "netadmin-pod").
+		Values("node").As("n").
+		V().HasLabel("Container").
+		Has("node", __.Where(P.Eq("n"))).
+		Has("name", P.Neq("netadmin-pod")).
+		Values("name").
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 1)
+	expected := suite.resultsToStringArray(results)
+
+	// All of them should be reachable via CE_NET_MITM
+	results, err = suite.g.V().
+		HasLabel("Container").
+		Has("name", "netadmin-pod").
+		OutE().HasLabel("CE_NET_MITM").
+		InV().HasLabel("Container").
+		Values("name").
+		ToList()
+
+	suite.NoError(err)
+	reachable := suite.resultsToStringArray(results)
+	suite.ElementsMatch(expected, reachable)
+
+	// Endpoints can only be intercepted if served from the same node
+	rawCount, err := suite.g.V().
+		HasLabel("Container").
+		Has("name", "netadmin-pod").
+		Values("node").As("n").
+		V().HasLabel("Container").
+		Has("name", "netadmin-pod").
+		OutE().HasLabel("CE_NET_MITM").
+		InV().HasLabel("Endpoint").
+		OutE().HasLabel("ENDPOINT_EXPLOIT").
+		InV().HasLabel("Container").
+		Not(__.Has("node", __.Where(P.Eq("n")))).
+		Count().Next()
+
+	suite.NoError(err)
+	count, err := rawCount.GetInt()
+	suite.NoError(err)
+	suite.Equal(0, count)
+}
+
+func (suite *EdgeTestSuite) TestEdge_CE_NET_MITM_DefaultCapabilities() {
+	// Host network containers keep the runtime default NET_RAW capability unless dropped
+	results, err := suite.g.V().
+		HasLabel("Container").
+		Has("namespace", "net-mitm").
+		Where(__.OutE("CE_NET_MITM")).
+		Values("name").
+		ToList()
+
+	suite.NoError(err)
+	suite.ElementsMatch(suite.resultsToStringArray(results), []string{
+		"netraw-default-pod",
+	})
+}
+
+func (suite *EdgeTestSuite) TestEdge_CE_NET_MITM_HostNetworkDaemonSet() {
+	// kube-proxy runs as a privileged host network daemonset, its edges must be bounded by the containers of its node
+	results, err := suite.g.V().
+		HasLabel("Container").
+		Has("name", "kube-proxy").
+		Values("node").
+		ToList()
+
+	suite.NoError(err)
+	nodes := suite.resultsToStringArray(results)
+	suite.Len(nodes, 3) // 1 per node
+
+	for _, node := range nodes {
+		rawCount, err := suite.g.V().
+			HasLabel("Container").
+			Has("node", node).
+			Count().Next()
+
+		suite.NoError(err)
+		containers, err := rawCount.GetInt()
+		suite.NoError(err)
+
+		rawCount, err = suite.g.V().
+			HasLabel("Container").
+			Has("name", "kube-proxy").
+			Has("node", node).
+			OutE().HasLabel("CE_NET_MITM").
+			InV().HasLabel("Container").
+			Has("node", node).
+			Count().Next()
+
+		suite.NoError(err)
+		edges, err := rawCount.GetInt()
+		suite.NoError(err)
+		suite.Equal(containers-1, edges, "node %s", node)
+
+		rawCount, err = suite.g.V().
+			HasLabel("Container").
+			Has("name", "kube-proxy").
+			Has("node", node).
+			OutE().HasLabel("CE_NET_MITM").
+			InV().HasLabel("Container").
+			Has("node", P.Neq(node)).
+			Count().Next()
+
+		suite.NoError(err)
+		offNode, err := rawCount.GetInt()
+		suite.NoError(err)
+		suite.Equal(0, offNode, "node %s", node)
+	}
+}
+
 func (suite *EdgeTestSuite) TestEdge_CONTAINER_ATTACH() {
 	// Every container should have a CONTAINER_ATTACH incoming from a pod
 	rawCount, err := suite.g.V().
@@ -223,6 +382,101 @@ func (suite *EdgeTestSuite) TestEdge_IDENTITY_ASSUME_Node() {
 	suite.Subset(paths, expected)
 }
 
+func (suite *EdgeTestSuite) TestEdge_IDENTITY_MEMBER() {
+	// Default cluster role bindings grant discovery permissions to the system:authenticated and
+	// system:serviceaccounts groups, so every service account should be linked to these
+	results, err := suite.g.V().
+		HasLabel("Identity").
+		Has("type", "ServiceAccount").
+		OutE().HasLabel("IDENTITY_MEMBER").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 2)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[tokenget-sa]], map[], map[name:[system:authenticated]",
+		"path[map[name:[tokenget-sa]], map[], map[name:[system:serviceaccounts]",
+		"path[map[name:[tokenlist-sa]], map[], map[name:[system:authenticated]",
+		"path[map[name:[tokenlist-sa]], map[], map[name:[system:serviceaccounts]",
+	}
+	suite.Subset(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_IDENTITY_IMPERSONATE() {
+	// Users and groups are not namespaced, so impersonating them requires a cluster-wide binding
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("name", "impersonate-users-groups::impersonate-users-groups").
+		OutE().HasLabel("IDENTITY_IMPERSONATE").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 3)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[impersonate-users-groups::impersonate-users-groups]], map[], map[name:[user-rb-r-rb-r]",
+		"path[map[name:[impersonate-users-groups::impersonate-users-groups]], map[], map[name:[group-rb-r-rb-r]",
+		"path[map[name:[impersonate-users-groups::impersonate-users-groups]], map[], map[name:[system:nodes]",
+	}
+	suite.Subset(paths, expected)
+
+	rawCount, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("name", "impersonate-users-groups::impersonate-users-groups").
+		OutE().HasLabel("IDENTITY_IMPERSONATE").
+		InV().HasLabel("Identity").
+		Has("type", "ServiceAccount").
+		Count().Next()
+
+	suite.NoError(err)
+	count, err := rawCount.GetInt()
+	suite.NoError(err)
+	suite.Equal(0, count)
+
+	// The same permission granted within a namespace does not allow to impersonate any user or group
+	rawCount, err = suite.g.V().
+		HasLabel("PermissionSet").
+		Has("name", "impersonate::pod-impersonate").
+		OutE().HasLabel("IDENTITY_IMPERSONATE").
+		Count().Next()
+
+	suite.NoError(err)
+	count, err = rawCount.GetInt()
+	suite.NoError(err)
+	suite.Equal(0, count)
+}
+
+func (suite *EdgeTestSuite) TestEdge_IDENTITY_IMPERSONATE_ServiceAccount() {
+	// The impersonate role is bound within the impersonate namespace, so only the service accounts of the namespace can
+	// be impersonated
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("name", "impersonate-serviceaccounts::impersonate-serviceaccounts").
+		OutE().HasLabel("IDENTITY_IMPERSONATE").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[impersonate-serviceaccounts::impersonate-serviceaccounts]], map[], map[name:[impersonate-cluster-sa]",
+		"path[map[name:[impersonate-serviceaccounts::impersonate-serviceaccounts]], map[], map[name:[impersonate-ns-sa]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
 func (suite *EdgeTestSuite) TestEdge_POD_ATTACH() {
 	// Every pod should have a POD_ATTACH incoming from a node
 	rawCount, err := suite.g.V().
@@ -264,6 +518,7 @@ func (suite *EdgeTestSuite) TestEdge_POD_PATCH() {
 
 	paths := suite.pathsToStringArray(results)
 	expected := []string{
+		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[containerd-sock-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[control-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[endpoints-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[host-read-exploit-pod]",
@@ -271,6 +526,7 @@ func (suite *EdgeTestSuite) TestEdge_POD_PATCH() {
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[impersonate-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[modload-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[netadmin-pod]",
+		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[netpol-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[nsenter-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[pod-create-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[pod-exec-pod]",
@@ -293,6 +549,29 @@ func (suite *EdgeTestSuite) TestEdge_POD_PATCH() {
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[tokenlist-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[umh-core-pod]",
 		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[varlog-pod]",
+		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[webhook-server-pod]",
+		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[webhook-target-pod]",
+		"path[map[name:[patch-pods::pod-patch-pods]], map[], map[name:[webhook-write-pod]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_POD_PATCH_Named() {
+	// The pod patch permission is restricted to a single pod of the namespace
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "resource-names").
+		OutE().HasLabel("POD_PATCH").
+		InV().HasLabel("Pod").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[patch-named-pods::named-patch-pods]], map[], map[name:[named-target-pod]",
 	}
 	suite.ElementsMatch(paths, expected)
 }
@@ -321,6 +600,51 @@ func (suite *EdgeTestSuite) TestEdge_POD_CREATE() {
 	suite.Subset(paths, expected)
 }
 
+func (suite *EdgeTestSuite) TestEdge_WEBHOOK_WRITE() {
+	// We have one bespoke container running with mutatingwebhookconfigurations/patch permissions which should reach all
+	// nodes since webhook configurations are cluster scoped
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("name", "write-mutating-webhooks::webhook-write-mutating-webhooks").
+		OutE().HasLabel("WEBHOOK_WRITE").
+		InV().HasLabel("Node").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 3)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[write-mutating-webhooks::webhook-write-mutating-webhooks]], map[], map[name:[kubehound.test.local-control-plane]",
+		"path[map[name:[write-mutating-webhooks::webhook-write-mutating-webhooks]], map[], map[name:[kubehound.test.local-worker]",
+		"path[map[name:[write-mutating-webhooks::webhook-write-mutating-webhooks]], map[], map[name:[kubehound.test.local-worker2]",
+	}
+	suite.Subset(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_WEBHOOK_MUTATE() {
+	// The container serving the webhook should only reach the pods matching the object selector of the webhook
+	results, err := suite.g.V().
+		HasLabel("Container").
+		Has("name", "webhook-server-pod").
+		OutE().HasLabel("WEBHOOK_MUTATE").
+		InV().HasLabel("Pod").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+	suite.Equal(1, len(results))
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[webhook-server-pod]], map[], map[name:[webhook-target-pod]",
+	}
+	suite.Subset(paths, expected)
+}
+
 func (suite *EdgeTestSuite) TestEdge_POD_EXEC() {
 	// We have one bespoke container running with pod/exec permissions which should reach all pods in the namespace
 	results, err := suite.g.V().
@@ -337,6 +661,7 @@ func (suite *EdgeTestSuite) TestEdge_POD_EXEC() {
 
 	paths := suite.pathsToStringArray(results)
 	expected := []string{
+		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[containerd-sock-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[control-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[endpoints-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[host-read-exploit-pod]",
@@ -344,6 +669,7 @@ func (suite *EdgeTestSuite) TestEdge_POD_EXEC() {
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[impersonate-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[modload-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[netadmin-pod]",
+		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[netpol-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[nsenter-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[pod-create-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[pod-exec-pod]",
@@ -366,6 +692,29 @@ func (suite *EdgeTestSuite) TestEdge_POD_EXEC() {
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[tokenlist-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[umh-core-pod]",
 		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[varlog-pod]",
+		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[webhook-server-pod]",
+		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[webhook-target-pod]",
+		"path[map[name:[exec-pods::pod-exec-pods]], map[], map[name:[webhook-write-pod]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_POD_EXEC_Named() {
+	// The pod exec permission is restricted to a single pod of the namespace
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "resource-names").
+		OutE().HasLabel("POD_EXEC").
+		InV().HasLabel("Pod").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[exec-named-pods::named-exec-pods]], map[], map[name:[named-target-pod]",
 	}
 	suite.ElementsMatch(paths, expected)
 }
@@ -413,6 +762,7 @@ func (suite *EdgeTestSuite) TestEdge_PERMISSION_DISCOVER() {
 		"path[map[name:[tokenget-sa]], map[], map[name:[read-secrets::pod-get-secrets]",
 		"path[map[name:[tokenlist-sa]], map[], map[name:[list-secrets::pod-list-secrets]",
 		"path[map[name:[varlog-sa]], map[], map[name:[read-logs::pod-read-logs]",
+		"path[map[name:[webhook-write-sa]], map[], map[name:[write-mutating-webhooks::webhook-write-mutating-webhooks]",
 	}
 
 	suite.ElementsMatch(paths, expected)
@@ -500,6 +850,27 @@ func (suite *EdgeTestSuite) TestEdge_TOKEN_BRUTEFORCE() {
 		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[tokenget-sa]",
 		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[tokenlist-sa]",
 		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[varlog-sa]",
+		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[webhook-write-sa]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_TOKEN_BRUTEFORCE_Named() {
+	// The secret get permission is restricted to the token secret of a single service account
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "resource-names").
+		OutE().HasLabel("TOKEN_BRUTEFORCE").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[read-named-secrets::named-read-secrets]], map[], map[name:[named-target-sa]",
 	}
 	suite.ElementsMatch(paths, expected)
 }
@@ -536,6 +907,52 @@ func (suite *EdgeTestSuite) TestEdge_TOKEN_LIST() {
 		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[tokenget-sa]",
 		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[tokenlist-sa]",
 		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[varlog-sa]",
+		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[webhook-write-sa]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_SECRET_READ() {
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "default").
+		OutE().HasLabel("SECRET_READ").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[impersonate-sa]",
+		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[impersonate-sa]",
+		// Kubeconfig secret authenticating with a client certificate as a user, member of a group
+		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[user-rb-r-rb-r]",
+		"path[map[name:[read-secrets::pod-get-secrets]], map[], map[name:[group-rb-r-rb-r]",
+		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[user-rb-r-rb-r]",
+		"path[map[name:[list-secrets::pod-list-secrets]], map[], map[name:[group-rb-r-rb-r]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_SECRET_READ_Named() {
+	// The secret get permission is restricted to the token secret of a single service account
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "resource-names").
+		OutE().HasLabel("SECRET_READ").
+		InV().HasLabel("Identity").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[read-named-secrets::named-read-secrets]], map[], map[name:[named-target-sa]",
 	}
 	suite.ElementsMatch(paths, expected)
 }
@@ -575,6 +992,7 @@ func (suite *EdgeTestSuite) TestEdge_TOKEN_STEAL() {
 		"tokenget-sa",
 		"tokenlist-sa",
 		"varlog-sa",
+		"webhook-write-sa",
 	}
 	suite.ElementsMatch(identities, expected)
 }
@@ -683,6 +1101,91 @@ func (suite *EdgeTestSuite) TestEdge_ENDPOINT_EXPLOIT_ContainerPort() {
 	suite.Subset(paths, expected)
 }
 
+func (suite *EdgeTestSuite) TestEdge_ENDPOINT_EXPLOIT_ClusterService() {
+	results, err := suite.g.V().
+		HasLabel("Endpoint").
+		Where(
+			__.Has("exposure", P.Eq(int(shared.EndpointExposureClusterIP))).
+				OutE("ENDPOINT_EXPLOIT").
+				InV().
+				HasLabel("Container")).
+		Values("serviceEndpoint").
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 1)
+
+	paths := suite.resultsToStringArray(results)
+	expected := []string{
+		"internal-service",
+	}
+
+	suite.Subset(paths, expected)
+}
+
+func (suite *EdgeTestSuite) TestEdge_ENDPOINT_EXPLOIT_NetworkPolicy() {
+	results, err := suite.g.V().
+		HasLabel("Endpoint").
+		Has("exposure", P.Eq(int(shared.EndpointExposureClusterIP))).
+		OutE("ENDPOINT_EXPLOIT").
+		Has("networkPolicy", shared.NetworkPolicyAccessRestricted).
+		OutV().
+		Values("serviceEndpoint").
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 1)
+
+	paths := suite.resultsToStringArray(results)
+	expected := []string{
+		"jmx-restricted",
+	}
+
+	suite.Subset(paths, expected)
+	suite.NotContains(paths, "jmx")
+
+	// Endpoints denied by the network policies must not be linked to their container
+	results, err = suite.g.V().
+		HasLabel("Endpoint").
+		Where(
+			__.OutE("ENDPOINT_EXPLOIT").
+				InV().
+				HasLabel("Container")).
+		Values("serviceEndpoint").
+		ToList()
+
+	suite.NoError(err)
+	suite.NotContains(suite.resultsToStringArray(results), "jmx-denied")
+}
+
+func (suite *EdgeTestSuite) TestEdge_INGRESS_EXPOSE() {
+	results, err := suite.g.V().
+		HasLabel("Ingress").
+		Has("name", "edge-ingress").
+		Has("hosts", "webproxy.kubehound.test").
+		OutE("INGRESS_EXPOSE").
+		InV().
+		HasLabel("Endpoint").
+		Where(
+			__.OutE("ENDPOINT_EXPLOIT").
+				InV().
+				HasLabel("Container")).
+		Values("serviceEndpoint").
+		ToList()
+
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(results), 1)
+
+	paths := suite.resultsToStringArray(results)
+	expected := []string{
+		"webproxy-service",
+		"internal-service",
+	}
+
+	suite.Subset(paths, expected)
+	suite.NotContains(paths, "host-port-svc")
+}
+
 func (suite *EdgeTestSuite) TestEdge_ENDPOINT_EXPLOIT_NodePort() {
 	results, err := suite.g.V().
 		HasLabel("Endpoint").
@@ -725,6 +1228,7 @@ func (suite *EdgeTestSuite) TestEdge_ENDPOINT_EXPLOIT_External() {
 	}
 
 	suite.Subset(paths, expected)
+	suite.NotContains(paths, "internal-service")
 }
 
 func (suite *EdgeTestSuite) TestEdge_SHARE_PS_NAMESPACE() {
@@ -908,6 +1412,26 @@ func (suite *EdgeTestSuite) TestEdge_ROLE_BIND_CASE_4() {
 	suite.ElementsMatch(paths, expected)
 }
 
+func (suite *EdgeTestSuite) TestEdge_ROLE_BIND_Named() {
+	// The bind permission is restricted to a single role of the namespace
+	results, err := suite.g.V().
+		HasLabel("PermissionSet").
+		Has("namespace", "resource-names").
+		OutE().HasLabel("ROLE_BIND").
+		InV().HasLabel("PermissionSet").
+		Path().
+		By(__.ValueMap("name")).
+		ToList()
+
+	suite.NoError(err)
+
+	paths := suite.pathsToStringArray(results)
+	expected := []string{
+		"path[map[name:[bind-named-roles::named-bind-roles]], map[], map[name:[named-bind-target::named-bind-target]",
+	}
+	suite.ElementsMatch(paths, expected)
+}
+
 func (suite *EdgeTestSuite) Test_NoEdgeCase() {
 	// The control pod has no interesting properties and therefore should have NO outgoing edges
 	results, err := suite.g.V().
@@ -131,6 +131,9 @@ func (suite *VertexTestSuite) TestVertexContainer() {
 		imageName, ok := converted["image"].(string)
 		suite.True(ok, "failed to convert image name to string")
 
+		containerType, ok := converted["containerType"].(string)
+		suite.True(ok, "failed to convert container type to string")
+
 		compromised, ok := converted["compromised"].(int32)
 		suite.True(ok, "failed to convert compromised field to CompromiseType")
 
@@ -163,6 +166,7 @@ func (suite *VertexTestSuite) TestVertexContainer() {
 		resultsMap[containerName] = graph.Container{
 			StoreID:      "",
 			Name:         containerName,
+			Type:         containerType,
 			Image:        imageName,
 			Command:      []string{},
 			Args:         []string{},
@@ -314,7 +318,7 @@ func (suite *VertexTestSuite) TestVertexCritical() {
 func (suite *VertexTestSuite) TestVertexVolume() {
 	results, err := suite.g.V().HasLabel(vertex.VolumeLabel).ElementMap().ToList()
 	suite.NoError(err)
-	suite.Equal(61, len(results))
+	suite.Equal(65, len(results))
 
 	results, err = suite.g.V().HasLabel(vertex.VolumeLabel).Has("sourcePath", "/proc/sys/kernel").Has("name", "nodeproc").ElementMap().ToList()
 	suite.NoError(err)
@@ -1,5 +1,5 @@
 // PLEASE DO NOT EDIT
-// THIS HAS BEEN GENERATED AUTOMATICALLY on 2024-01-15 09:37
+// THIS HAS BEEN GENERATED AUTOMATICALLY on 2026-10-18 12:09
 //
 // Generate it with "go generate ./..."
 //
@@ -19,6 +19,26 @@ import (
 )
 
 var expectedPods = map[string]graph.Pod{
+	"container-types-pod": {
+		StoreID:               "",
+		Name:                  "container-types-pod",
+		IsNamespaced:          true,
+		Namespace:             "container-types",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"containerd-sock-pod": {
+		StoreID:               "",
+		Name:                  "containerd-sock-pod",
+		IsNamespaced:          true,
+		Namespace:             "default",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
 	"control-pod": {
 		StoreID:               "",
 		Name:                  "control-pod",
@@ -79,6 +99,26 @@ var expectedPods = map[string]graph.Pod{
 		ShareProcessNamespace: false,
 		Critical:              false,
 	},
+	"named-sa-pod": {
+		StoreID:               "",
+		Name:                  "named-sa-pod",
+		IsNamespaced:          true,
+		Namespace:             "resource-names",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "named-sa",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"named-target-pod": {
+		StoreID:               "",
+		Name:                  "named-target-pod",
+		IsNamespaced:          true,
+		Namespace:             "resource-names",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "named-target-sa",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
 	"netadmin-pod": {
 		StoreID:               "",
 		Name:                  "netadmin-pod",
@@ -89,6 +129,46 @@ var expectedPods = map[string]graph.Pod{
 		ShareProcessNamespace: false,
 		Critical:              false,
 	},
+	"netpol-pod": {
+		StoreID:               "",
+		Name:                  "netpol-pod",
+		IsNamespaced:          true,
+		Namespace:             "default",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"netraw-default-pod": {
+		StoreID:               "",
+		Name:                  "netraw-default-pod",
+		IsNamespaced:          true,
+		Namespace:             "net-mitm",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"netraw-drop-all-pod": {
+		StoreID:               "",
+		Name:                  "netraw-drop-all-pod",
+		IsNamespaced:          true,
+		Namespace:             "net-mitm",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"netraw-drop-pod": {
+		StoreID:               "",
+		Name:                  "netraw-drop-pod",
+		IsNamespaced:          true,
+		Namespace:             "net-mitm",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
 	"nsenter-pod": {
 		StoreID:               "",
 		Name:                  "nsenter-pod",
@@ -319,6 +399,36 @@ var expectedPods = map[string]graph.Pod{
 		ShareProcessNamespace: false,
 		Critical:              false,
 	},
+	"webhook-server-pod": {
+		StoreID:               "",
+		Name:                  "webhook-server-pod",
+		IsNamespaced:          true,
+		Namespace:             "default",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"webhook-target-pod": {
+		StoreID:               "",
+		Name:                  "webhook-target-pod",
+		IsNamespaced:          true,
+		Namespace:             "default",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "default",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
+	"webhook-write-pod": {
+		StoreID:               "",
+		Name:                  "webhook-write-pod",
+		IsNamespaced:          true,
+		Namespace:             "default",
+		Compromised:           shared.CompromiseNone,
+		ServiceAccount:        "webhook-write-sa",
+		ShareProcessNamespace: false,
+		Critical:              false,
+	},
 }
 
 var expectedNodes = map[string]graph.Node{
@@ -349,6 +459,15 @@ var expectedNodes = map[string]graph.Node{
 }
 
 var expectedVolumes = map[string]graph.Volume{
+	"containerdsock": {
+		StoreID:    "",
+		Name:       "containerdsock",
+		Type:       "",
+		SourcePath: "",
+		MountPath:  "/host/run/containerd/containerd.sock",
+		Readonly:   true,
+		Namespace:  "default",
+	},
 	"host-pod-dir": {
 		StoreID:    "",
 		Name:       "host-pod-dir",
@@ -397,9 +516,110 @@ var expectedVolumes = map[string]graph.Volume{
 }
 
 var expectedContainers = map[string]graph.Container{
+	"container-types-app": {
+		StoreID:      "",
+		Name:         "container-types-app",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      true,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "container-types",
+		Ports:        []string{},
+		Pod:          "container-types-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"container-types-debugger": {
+		StoreID:      "",
+		Name:         "container-types-debugger",
+		Type:         "Ephemeral",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   true,
+		PrivEsc:      false,
+		HostPID:      true,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "container-types",
+		Ports:        []string{},
+		Pod:          "container-types-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"container-types-init": {
+		StoreID:      "",
+		Name:         "container-types-init",
+		Type:         "Init",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   true,
+		PrivEsc:      false,
+		HostPID:      true,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "container-types",
+		Ports:        []string{},
+		Pod:          "container-types-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"container-types-sidecar": {
+		StoreID:      "",
+		Name:         "container-types-sidecar",
+		Type:         "Sidecar",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   true,
+		PrivEsc:      false,
+		HostPID:      true,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "container-types",
+		Ports:        []string{},
+		Pod:          "container-types-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"containerd-sock-pod": {
+		StoreID:      "",
+		Name:         "containerd-sock-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "default",
+		Ports:        []string{},
+		Pod:          "containerd-sock-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
 	"control-pod": {
 		StoreID:      "",
 		Name:         "control-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -419,6 +639,7 @@ var expectedContainers = map[string]graph.Container{
 	"endpoints-pod": {
 		StoreID:      "",
 		Name:         "endpoints-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -438,6 +659,7 @@ var expectedContainers = map[string]graph.Container{
 	"host-read-exploit-pod": {
 		StoreID:      "",
 		Name:         "host-read-exploit-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -457,6 +679,7 @@ var expectedContainers = map[string]graph.Container{
 	"host-write-exploit-pod": {
 		StoreID:      "",
 		Name:         "host-write-exploit-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -476,6 +699,7 @@ var expectedContainers = map[string]graph.Container{
 	"impersonate-pod": {
 		StoreID:      "",
 		Name:         "impersonate-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -495,6 +719,7 @@ var expectedContainers = map[string]graph.Container{
 	"modload-pod": {
 		StoreID:      "",
 		Name:         "modload-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -511,9 +736,50 @@ var expectedContainers = map[string]graph.Container{
 		// Node:         "",
 		Compromised: 0,
 	},
+	"named-sa-pod": {
+		StoreID:      "",
+		Name:         "named-sa-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "resource-names",
+		Ports:        []string{},
+		Pod:          "named-sa-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"named-target-pod": {
+		StoreID:      "",
+		Name:         "named-target-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "resource-names",
+		Ports:        []string{},
+		Pod:          "named-target-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
 	"netadmin-pod": {
 		StoreID:      "",
 		Name:         "netadmin-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -530,9 +796,90 @@ var expectedContainers = map[string]graph.Container{
 		// Node:         "",
 		Compromised: 0,
 	},
+	"netpol-pod": {
+		StoreID:      "",
+		Name:         "netpol-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "default",
+		Ports:        []string{},
+		Pod:          "netpol-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"netraw-default-pod": {
+		StoreID:      "",
+		Name:         "netraw-default-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  true,
+		RunAsUser:    0,
+		Namespace:    "net-mitm",
+		Ports:        []string{},
+		Pod:          "netraw-default-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"netraw-drop-all-pod": {
+		StoreID:      "",
+		Name:         "netraw-drop-all-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  true,
+		RunAsUser:    0,
+		Namespace:    "net-mitm",
+		Ports:        []string{},
+		Pod:          "netraw-drop-all-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"netraw-drop-pod": {
+		StoreID:      "",
+		Name:         "netraw-drop-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  true,
+		RunAsUser:    0,
+		Namespace:    "net-mitm",
+		Ports:        []string{},
+		Pod:          "netraw-drop-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
 	"nsenter-pod": {
 		StoreID:      "",
 		Name:         "nsenter-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -552,6 +899,7 @@ var expectedContainers = map[string]graph.Container{
 	"pod-create-pod": {
 		StoreID:      "",
 		Name:         "pod-create-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -571,6 +919,7 @@ var expectedContainers = map[string]graph.Container{
 	"pod-exec-pod": {
 		StoreID:      "",
 		Name:         "pod-exec-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -590,6 +939,7 @@ var expectedContainers = map[string]graph.Container{
 	"pod-patch-pod": {
 		StoreID:      "",
 		Name:         "pod-patch-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -609,6 +959,7 @@ var expectedContainers = map[string]graph.Container{
 	"priv-pod": {
 		StoreID:      "",
 		Name:         "priv-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -628,6 +979,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-crb-cr-crb-cr": {
 		StoreID:      "",
 		Name:         "rolebind-pod-crb-cr-crb-cr",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -647,6 +999,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-crb-cr-crb-r-fail": {
 		StoreID:      "",
 		Name:         "rolebind-pod-crb-cr-crb-r-fail",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -666,6 +1019,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-crb-cr-rb-cr": {
 		StoreID:      "",
 		Name:         "rolebind-pod-crb-cr-rb-cr",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -685,6 +1039,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-crb-cr-rb-r": {
 		StoreID:      "",
 		Name:         "rolebind-pod-crb-cr-rb-r",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -704,6 +1059,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-cr-crb-cr-fail": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-cr-crb-cr-fail",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -723,6 +1079,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-cr-rb-cr": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-cr-rb-cr",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -742,6 +1099,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-cr-rb-r": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-cr-rb-r",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -761,6 +1119,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-r-crb-cr-fail": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-r-crb-cr-fail",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -780,6 +1139,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-r-rb-crb": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-r-rb-crb",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -799,6 +1159,7 @@ var expectedContainers = map[string]graph.Container{
 	"rolebind-pod-rb-r-rb-r": {
 		StoreID:      "",
 		Name:         "rolebind-pod-rb-r-rb-r",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -818,6 +1179,7 @@ var expectedContainers = map[string]graph.Container{
 	"sharedps-pod1-a": {
 		StoreID:      "",
 		Name:         "sharedps-pod1-a",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -837,6 +1199,7 @@ var expectedContainers = map[string]graph.Container{
 	"sharedps-pod1-b": {
 		StoreID:      "",
 		Name:         "sharedps-pod1-b",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -856,6 +1219,7 @@ var expectedContainers = map[string]graph.Container{
 	"sharedps-pod1-c": {
 		StoreID:      "",
 		Name:         "sharedps-pod1-c",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -875,6 +1239,7 @@ var expectedContainers = map[string]graph.Container{
 	"sharedps-pod2-a": {
 		StoreID:      "",
 		Name:         "sharedps-pod2-a",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -894,6 +1259,7 @@ var expectedContainers = map[string]graph.Container{
 	"sharedps-pod2-b": {
 		StoreID:      "",
 		Name:         "sharedps-pod2-b",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -913,6 +1279,7 @@ var expectedContainers = map[string]graph.Container{
 	"sys-ptrace-pod": {
 		StoreID:      "",
 		Name:         "sys-ptrace-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -932,6 +1299,7 @@ var expectedContainers = map[string]graph.Container{
 	"tokenget-pod": {
 		StoreID:      "",
 		Name:         "tokenget-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -951,6 +1319,7 @@ var expectedContainers = map[string]graph.Container{
 	"tokenlist-pod": {
 		StoreID:      "",
 		Name:         "tokenlist-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -970,6 +1339,7 @@ var expectedContainers = map[string]graph.Container{
 	"umh-core-pod": {
 		StoreID:      "",
 		Name:         "umh-core-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -989,6 +1359,7 @@ var expectedContainers = map[string]graph.Container{
 	"varlog-container": {
 		StoreID:      "",
 		Name:         "varlog-container",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -1008,6 +1379,7 @@ var expectedContainers = map[string]graph.Container{
 	"vault-pod": {
 		StoreID:      "",
 		Name:         "vault-pod",
+		Type:         "Regular",
 		Image:        "ubuntu",
 		Command:      []string{},
 		Args:         []string{},
@@ -1024,9 +1396,79 @@ var expectedContainers = map[string]graph.Container{
 		// Node:         "",
 		Compromised: 0,
 	},
+	"webhook-server-pod": {
+		StoreID:      "",
+		Name:         "webhook-server-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "default",
+		Ports:        []string{},
+		Pod:          "webhook-server-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"webhook-target-pod": {
+		StoreID:      "",
+		Name:         "webhook-target-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "default",
+		Ports:        []string{},
+		Pod:          "webhook-target-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
+	"webhook-write-pod": {
+		StoreID:      "",
+		Name:         "webhook-write-pod",
+		Type:         "Regular",
+		Image:        "ubuntu",
+		Command:      []string{},
+		Args:         []string{},
+		Capabilities: []string{},
+		Privileged:   false,
+		PrivEsc:      false,
+		HostPID:      false,
+		HostIPC:      false,
+		HostNetwork:  false,
+		RunAsUser:    0,
+		Namespace:    "default",
+		Ports:        []string{},
+		Pod:          "webhook-write-pod",
+		// Node:         "",
+		Compromised: 0,
+	},
 }
 
 var expectedPermissionSets = map[string]graph.PermissionSet{
+	"bind-named-roles::named-bind-roles": {
+		StoreID:      "",
+		Name:         "bind-named-roles::named-bind-roles",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Role:         "bind-named-roles",
+		Rules:        []string{"API(rbac.authorization.k8s.io)::R(rolebindings)::N()::V(create)", "API(rbac.authorization.k8s.io)::R(roles)::N(named-bind-target)::V(bind)"},
+		RoleBinding:  "named-bind-roles",
+		Critical:     false,
+	},
 	"create-pods::pod-create-pods": {
 		StoreID:      "",
 		Name:         "create-pods::pod-create-pods",
@@ -1037,6 +1479,16 @@ var expectedPermissionSets = map[string]graph.PermissionSet{
 		RoleBinding:  "pod-create-pods",
 		Critical:     false,
 	},
+	"exec-named-pods::named-exec-pods": {
+		StoreID:      "",
+		Name:         "exec-named-pods::named-exec-pods",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Role:         "exec-named-pods",
+		Rules:        []string{"API()::R(pods)::N()::V(get,list)", "API()::R(pods/exec)::N(named-target-pod)::V(create)"},
+		RoleBinding:  "named-exec-pods",
+		Critical:     false,
+	},
 	"exec-pods::pod-exec-pods": {
 		StoreID:      "",
 		Name:         "exec-pods::pod-exec-pods",
@@ -1047,6 +1499,16 @@ var expectedPermissionSets = map[string]graph.PermissionSet{
 		RoleBinding:  "pod-exec-pods",
 		Critical:     false,
 	},
+	"impersonate-serviceaccounts::impersonate-serviceaccounts": {
+		StoreID:      "",
+		Name:         "impersonate-serviceaccounts::impersonate-serviceaccounts",
+		IsNamespaced: true,
+		Namespace:    "impersonate",
+		Role:         "impersonate-serviceaccounts",
+		Rules:        []string{"API()::R(serviceaccounts)::N()::V(impersonate)"},
+		RoleBinding:  "impersonate-serviceaccounts",
+		Critical:     false,
+	},
 	"impersonate::pod-impersonate": {
 		StoreID:      "",
 		Name:         "impersonate::pod-impersonate",
@@ -1067,6 +1529,26 @@ var expectedPermissionSets = map[string]graph.PermissionSet{
 		RoleBinding:  "pod-list-secrets",
 		Critical:     false,
 	},
+	"named-bind-target::named-bind-target": {
+		StoreID:      "",
+		Name:         "named-bind-target::named-bind-target",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Role:         "named-bind-target",
+		Rules:        []string{"API()::R(configmaps)::N()::V(get,list)"},
+		RoleBinding:  "named-bind-target",
+		Critical:     false,
+	},
+	"patch-named-pods::named-patch-pods": {
+		StoreID:      "",
+		Name:         "patch-named-pods::named-patch-pods",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Role:         "patch-named-pods",
+		Rules:        []string{"API()::R(pods)::N(named-target-pod)::V(get,patch)"},
+		RoleBinding:  "named-patch-pods",
+		Critical:     false,
+	},
 	"patch-pods::pod-patch-pods": {
 		StoreID:      "",
 		Name:         "patch-pods::pod-patch-pods",
@@ -1087,6 +1569,16 @@ var expectedPermissionSets = map[string]graph.PermissionSet{
 		RoleBinding:  "pod-read-logs",
 		Critical:     false,
 	},
+	"read-named-secrets::named-read-secrets": {
+		StoreID:      "",
+		Name:         "read-named-secrets::named-read-secrets",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Role:         "read-named-secrets",
+		Rules:        []string{"API()::R(secrets)::N(named-target-sa-token-fixture)::V(get)"},
+		RoleBinding:  "named-read-secrets",
+		Critical:     false,
+	},
 	"read-secrets::pod-get-secrets": {
 		StoreID:      "",
 		Name:         "read-secrets::pod-get-secrets",
@@ -1244,6 +1736,22 @@ var expectedIdentities = map[string]graph.Identity{
 		Type:         "Group",
 		Critical:     false,
 	},
+	"impersonate-cluster-sa": {
+		StoreID:      "",
+		Name:         "impersonate-cluster-sa",
+		IsNamespaced: true,
+		Namespace:    "impersonate",
+		Type:         "ServiceAccount",
+		Critical:     false,
+	},
+	"impersonate-ns-sa": {
+		StoreID:      "",
+		Name:         "impersonate-ns-sa",
+		IsNamespaced: true,
+		Namespace:    "impersonate",
+		Type:         "ServiceAccount",
+		Critical:     false,
+	},
 	"impersonate-sa": {
 		StoreID:      "",
 		Name:         "impersonate-sa",
@@ -1252,6 +1760,22 @@ var expectedIdentities = map[string]graph.Identity{
 		Type:         "ServiceAccount",
 		Critical:     false,
 	},
+	"named-sa": {
+		StoreID:      "",
+		Name:         "named-sa",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Type:         "ServiceAccount",
+		Critical:     false,
+	},
+	"named-target-sa": {
+		StoreID:      "",
+		Name:         "named-target-sa",
+		IsNamespaced: true,
+		Namespace:    "resource-names",
+		Type:         "ServiceAccount",
+		Critical:     false,
+	},
 	"pod-create-sa": {
 		StoreID:      "",
 		Name:         "pod-create-sa",
@@ -1412,4 +1936,12 @@ var expectedIdentities = map[string]graph.Identity{
 		Type:         "ServiceAccount",
 		Critical:     false,
 	},
+	"webhook-write-sa": {
+		StoreID:      "",
+		Name:         "webhook-write-sa",
+		IsNamespaced: true,
+		Namespace:    "default",
+		Type:         "ServiceAccount",
+		Critical:     false,
+	},
 }